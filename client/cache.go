@@ -0,0 +1,122 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// cache holds the last known good content of files in memory and, when it
+// has a directory, on disk
+type cache struct {
+	dir string
+
+	mu    sync.Mutex
+	files map[string]*File
+}
+
+func newCache(dir string) *cache {
+	return &cache{dir: dir, files: make(map[string]*File)}
+}
+
+// cacheKey identifies a file of a namespace; the empty owner is the
+// namespace of the caller
+func cacheKey(owner, filename string) string {
+	return owner + "/" + filename
+}
+
+// path returns the location of a cached file on disk. Keys are escaped
+// into a single name so files of every namespace share one directory.
+func (c *cache) path(key string) string {
+	return filepath.Join(c.dir, url.PathEscape(key)+".json")
+}
+
+// get returns the cached copy of a file, reading it from disk if it is not
+// in memory yet
+func (c *cache) get(owner, filename string) (*File, bool) {
+	key := cacheKey(owner, filename)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if file, ok := c.files[key]; ok {
+		return file.clone(), true
+	}
+	if c.dir == "" {
+		return nil, false
+	}
+
+	data, err := os.ReadFile(c.path(key))
+	if err != nil {
+		return nil, false
+	}
+	var file File
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, false
+	}
+	c.files[key] = &file
+	return file.clone(), true
+}
+
+// put stores a copy of a file that was read from the server
+func (c *cache) put(file *File) error {
+	key := cacheKey(file.Owner, file.Filename)
+	cached := file.clone()
+	cached.Stale = false
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.files[key] = cached
+	if c.dir == "" {
+		return nil
+	}
+
+	data, err := json.Marshal(cached)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(c.dir, 0700); err != nil {
+		return err
+	}
+
+	// Write to a temporary file and rename it so readers never see a
+	// partially written copy
+	tmp, err := os.CreateTemp(c.dir, ".cache-*")
+	if err != nil {
+		return err
+	}
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), c.path(key))
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return nil
+}
+
+// remove drops the cached copy of a file that was deleted on the server
+func (c *cache) remove(owner, filename string) error {
+	key := cacheKey(owner, filename)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.files, key)
+	if c.dir == "" {
+		return nil
+	}
+	if err := os.Remove(c.path(key)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
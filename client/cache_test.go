@@ -0,0 +1,88 @@
+package client
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCacheSurvivesRestarts(t *testing.T) {
+	dir := t.TempDir()
+	files := []*File{
+		{Filename: "app.json", Data: []byte("own"), Revision: 1},
+		{Owner: "team:ops", Filename: "app.json", Data: []byte("team"), Revision: 2},
+		{Filename: "services/db/../db.yaml", Data: []byte("nested"), Revision: 3},
+	}
+	c := newCache(dir)
+	for _, file := range files {
+		if err := c.put(file); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Every key is a single escaped file name in the cache directory
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != len(files) {
+		t.Errorf("%d files in the cache directory, want %d", len(entries), len(files))
+	}
+
+	restarted := newCache(dir)
+	for _, file := range files {
+		got, ok := restarted.get(file.Owner, file.Filename)
+		if !ok || string(got.Data) != string(file.Data) || got.Revision != file.Revision {
+			t.Errorf("get(%q, %q) = %+v, %v; want %+v", file.Owner, file.Filename, got, ok, file)
+		}
+	}
+}
+
+func TestCacheCopies(t *testing.T) {
+	c := newCache("")
+	file := &File{Filename: "a", Data: []byte("x"), Stale: true}
+	if err := c.put(file); err != nil {
+		t.Fatal(err)
+	}
+	file.Data[0] = 'y'
+
+	got, _ := c.get("", "a")
+	if string(got.Data) != "x" || got.Stale {
+		t.Errorf("get = %+v, want an unchanged copy that is not stale", got)
+	}
+	got.Data[0] = 'z'
+	if again, _ := c.get("", "a"); string(again.Data) != "x" {
+		t.Errorf("modifying a returned copy changed the cache to %q", again.Data)
+	}
+}
+
+func TestCacheRemove(t *testing.T) {
+	dir := t.TempDir()
+	c := newCache(dir)
+	if err := c.put(&File{Filename: "a"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.remove("", "a"); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.remove("", "never-cached"); err != nil {
+		t.Errorf("removing a file that was never cached: %v", err)
+	}
+	if _, ok := newCache(dir).get("", "a"); ok {
+		t.Error("removed file is still on disk")
+	}
+}
+
+func TestCacheIgnoresCorruptFiles(t *testing.T) {
+	dir := t.TempDir()
+	c := newCache(dir)
+	if err := os.WriteFile(c.path(cacheKey("", "a")), []byte("{"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := c.get("", "a"); ok {
+		t.Error("corrupt cache file was returned")
+	}
+	if matches, _ := filepath.Glob(filepath.Join(dir, ".cache-*")); len(matches) > 0 {
+		t.Errorf("temporary files left behind: %v", matches)
+	}
+}
@@ -0,0 +1,228 @@
+// Package client is the Go SDK of the config server. It wraps the generated
+// gRPC client with helpers to read and watch configuration files, retries
+// calls that failed because the server was unreachable and falls back to
+// the last known good content of a file so services can start while the
+// server is down.
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"math/rand"
+	"sync"
+	"time"
+
+	pb "github.com/yash3004/config_server/generated/protobuf/configpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultMaxAttempts    = 4
+	defaultInitialBackoff = 200 * time.Millisecond
+	defaultMaxBackoff     = 5 * time.Second
+)
+
+// Options configures a Client. The zero value connects without TLS or
+// credentials and keeps its cache in memory.
+type Options struct {
+	// Token is a bearer token or API key sent with every call. Login
+	// replaces it.
+	Token string
+	// TLS enables transport security with the given configuration
+	TLS *tls.Config
+	// CacheDir keeps the last known good content of the files read so it
+	// survives restarts. The cache is kept in memory only when it is empty.
+	CacheDir string
+	// MaxAttempts bounds how often a call is tried while the server is
+	// unreachable, defaulting to 4
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry, doubling with
+	// every further attempt up to MaxBackoff. They default to 200ms and 5s.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// Client reads configuration files from a config server
+type Client struct {
+	conn    *grpc.ClientConn
+	service pb.ConfigServiceClient
+	opts    Options
+	cache   *cache
+
+	mu    sync.RWMutex
+	token string
+}
+
+// New connects to the config server at target. Additional dial options are
+// applied after the ones derived from opts.
+func New(target string, opts Options, dialOpts ...grpc.DialOption) (*Client, error) {
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = defaultMaxAttempts
+	}
+	if opts.InitialBackoff <= 0 {
+		opts.InitialBackoff = defaultInitialBackoff
+	}
+	if opts.MaxBackoff <= 0 {
+		opts.MaxBackoff = defaultMaxBackoff
+	}
+
+	c := &Client{
+		opts:  opts,
+		cache: newCache(opts.CacheDir),
+		token: opts.Token,
+	}
+
+	transport := insecure.NewCredentials()
+	if opts.TLS != nil {
+		transport = credentials.NewTLS(opts.TLS)
+	}
+	dialOpts = append([]grpc.DialOption{
+		grpc.WithTransportCredentials(transport),
+		grpc.WithPerRPCCredentials(tokenCredentials{client: c}),
+	}, dialOpts...)
+
+	conn, err := grpc.Dial(target, dialOpts...)
+	if err != nil {
+		return nil, err
+	}
+	c.conn = conn
+	c.service = pb.NewConfigServiceClient(conn)
+	return c, nil
+}
+
+// Close closes the connection to the server
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// Service returns the generated client for calls without a helper. Its
+// calls carry the credentials of c but are not retried.
+func (c *Client) Service() pb.ConfigServiceClient {
+	return c.service
+}
+
+// Login exchanges a user's password for a bearer token used by every
+// further call and returns the token
+func (c *Client) Login(ctx context.Context, userID, password string) (string, error) {
+	var resp *pb.LoginResponse
+	err := c.retry(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = c.service.Login(ctx, &pb.Login{UserId: userID, Password: password})
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+
+	c.SetToken(resp.GetToken())
+	return resp.GetToken(), nil
+}
+
+// SetToken replaces the bearer token or API key sent with every call
+func (c *Client) SetToken(token string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.token = token
+}
+
+// tokenCredentials adds the current token of a client to every call
+type tokenCredentials struct {
+	client *Client
+}
+
+func (t tokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	t.client.mu.RLock()
+	defer t.client.mu.RUnlock()
+
+	if t.client.token == "" {
+		return nil, nil
+	}
+	return map[string]string{"authorization": "Bearer " + t.client.token}, nil
+}
+
+// RequireTransportSecurity allows tokens on plaintext connections, which
+// the server accepts as well
+func (t tokenCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+// retry calls fn until it succeeds, fails with an error other than the
+// server being unreachable, or MaxAttempts is reached
+func (c *Client) retry(ctx context.Context, fn func(ctx context.Context) error) error {
+	backoff := c.opts.InitialBackoff
+	for attempt := 1; ; attempt++ {
+		err := fn(ctx)
+		if err == nil || !Unavailable(err) || attempt == c.opts.MaxAttempts {
+			return err
+		}
+
+		if err := c.sleep(ctx, backoff); err != nil {
+			return err
+		}
+		backoff = c.nextBackoff(backoff)
+	}
+}
+
+// sleep waits for a backoff delay with up to 20% jitter or until ctx is done
+func (c *Client) sleep(ctx context.Context, backoff time.Duration) error {
+	jitter := time.Duration(rand.Int63n(int64(backoff)/5 + 1))
+	timer := time.NewTimer(backoff + jitter)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// nextBackoff doubles a backoff delay up to MaxBackoff
+func (c *Client) nextBackoff(backoff time.Duration) time.Duration {
+	backoff *= 2
+	if backoff > c.opts.MaxBackoff {
+		return c.opts.MaxBackoff
+	}
+	return backoff
+}
+
+// Unavailable reports whether err means the server could not be reached or
+// did not answer in time, in which case the call may succeed when retried
+func Unavailable(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted:
+		return true
+	}
+	return false
+}
+
+// ContentError returns where the content of a rejected write fails to parse
+// as the type of its file
+func ContentError(err error) (*pb.ContentError, bool) {
+	for _, detail := range status.Convert(err).Details() {
+		if contentErr, ok := detail.(*pb.ContentError); ok {
+			return contentErr, true
+		}
+	}
+	return nil, false
+}
+
+// SchemaViolations returns every violation of the schemas of a file that
+// made the server reject a write
+func SchemaViolations(err error) []*pb.SchemaViolation {
+	var violations []*pb.SchemaViolation
+	for _, detail := range status.Convert(err).Details() {
+		if violation, ok := detail.(*pb.SchemaViolation); ok {
+			violations = append(violations, violation)
+		}
+	}
+	return violations
+}
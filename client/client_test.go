@@ -0,0 +1,200 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	pb "github.com/yash3004/config_server/generated/protobuf/configpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeService answers GetConfig with a fixed sequence of results and opens
+// one watch stream per connection, see watch_test.go
+type fakeService struct {
+	pb.ConfigServiceClient
+	gets  []getResult
+	calls int
+
+	connections []fakeConnection
+	requests    []*pb.WatchConfig
+}
+
+type getResult struct {
+	resp *pb.GetConfigResponse
+	err  error
+}
+
+func (s *fakeService) GetConfig(ctx context.Context, in *pb.GetConfig, opts ...grpc.CallOption) (*pb.GetConfigResponse, error) {
+	result := s.gets[s.calls]
+	s.calls++
+	return result.resp, result.err
+}
+
+// newTestClient returns a client of service that retries without delay
+func newTestClient(service pb.ConfigServiceClient, cacheDir string) *Client {
+	return &Client{
+		service: service,
+		opts: Options{
+			CacheDir:       cacheDir,
+			MaxAttempts:    3,
+			InitialBackoff: time.Millisecond,
+			MaxBackoff:     time.Millisecond,
+		},
+		cache: newCache(cacheDir),
+	}
+}
+
+func TestGet(t *testing.T) {
+	unavailable := status.Error(codes.Unavailable, "down")
+	fresh := &pb.GetConfigResponse{Data: []byte("new"), Revision: 2, Etag: "e2"}
+	cached := &File{Filename: "app.json", Data: []byte("old"), Revision: 1, ETag: "e1"}
+
+	tests := []struct {
+		name      string
+		cached    *File
+		gets      []getResult
+		wantCalls int
+		want      *File
+		wantCode  codes.Code
+	}{
+		{
+			name:      "first attempt",
+			gets:      []getResult{{resp: fresh}},
+			wantCalls: 1,
+			want:      &File{Filename: "app.json", Data: []byte("new"), Revision: 2, ETag: "e2"},
+		},
+		{
+			name:      "retried while unavailable",
+			cached:    cached,
+			gets:      []getResult{{err: unavailable}, {err: status.Error(codes.DeadlineExceeded, "slow")}, {resp: fresh}},
+			wantCalls: 3,
+			want:      &File{Filename: "app.json", Data: []byte("new"), Revision: 2, ETag: "e2"},
+		},
+		{
+			name:      "cached copy after the last attempt",
+			cached:    cached,
+			gets:      []getResult{{err: unavailable}, {err: unavailable}, {err: unavailable}},
+			wantCalls: 3,
+			want:      &File{Filename: "app.json", Data: []byte("old"), Revision: 1, ETag: "e1", Stale: true},
+		},
+		{
+			name:      "unavailable without a cached copy",
+			gets:      []getResult{{err: unavailable}, {err: unavailable}, {err: unavailable}},
+			wantCalls: 3,
+			wantCode:  codes.Unavailable,
+		},
+		{
+			name:      "other errors are neither retried nor served from the cache",
+			cached:    cached,
+			gets:      []getResult{{err: status.Error(codes.NotFound, "gone")}},
+			wantCalls: 1,
+			wantCode:  codes.NotFound,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service := &fakeService{gets: test.gets}
+			c := newTestClient(service, "")
+			if test.cached != nil {
+				if err := c.cache.put(test.cached); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			file, err := c.Get(context.Background(), "", "app.json")
+			if service.calls != test.wantCalls {
+				t.Errorf("%d calls, want %d", service.calls, test.wantCalls)
+			}
+			if test.wantCode != codes.OK {
+				if status.Code(err) != test.wantCode {
+					t.Errorf("got error %v, want code %v", err, test.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if file.Revision != test.want.Revision || file.ETag != test.want.ETag || string(file.Data) != string(test.want.Data) || file.Stale != test.want.Stale {
+				t.Errorf("got %+v, want %+v", file, test.want)
+			}
+		})
+	}
+}
+
+func TestGetCachesTheLatestCopy(t *testing.T) {
+	dir := t.TempDir()
+	service := &fakeService{gets: []getResult{{resp: &pb.GetConfigResponse{Data: []byte("v2"), Revision: 2}}}}
+	if _, err := newTestClient(service, dir).Get(context.Background(), "team:ops", "app.json"); err != nil {
+		t.Fatal(err)
+	}
+
+	// A client started later finds the copy on disk
+	unavailable := status.Error(codes.Unavailable, "down")
+	service = &fakeService{gets: []getResult{{err: unavailable}, {err: unavailable}, {err: unavailable}}}
+	file, err := newTestClient(service, dir).Get(context.Background(), "team:ops", "app.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !file.Stale || string(file.Data) != "v2" || file.Owner != "team:ops" {
+		t.Errorf("got %+v, want the stale copy of revision 2", file)
+	}
+}
+
+func TestRetryStopsWhenContextIsDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	c := newTestClient(nil, "")
+	c.opts.InitialBackoff = time.Hour
+
+	calls := 0
+	err := c.retry(ctx, func(ctx context.Context) error {
+		calls++
+		cancel()
+		return status.Error(codes.Unavailable, "down")
+	})
+	if !errors.Is(err, context.Canceled) || calls != 1 {
+		t.Errorf("got %v after %d calls, want context.Canceled after 1", err, calls)
+	}
+}
+
+func TestNextBackoff(t *testing.T) {
+	c := &Client{opts: Options{MaxBackoff: 5 * time.Second}}
+	tests := []struct {
+		backoff time.Duration
+		want    time.Duration
+	}{
+		{200 * time.Millisecond, 400 * time.Millisecond},
+		{2 * time.Second, 4 * time.Second},
+		{3 * time.Second, 5 * time.Second},
+		{5 * time.Second, 5 * time.Second},
+	}
+	for _, test := range tests {
+		if got := c.nextBackoff(test.backoff); got != test.want {
+			t.Errorf("nextBackoff(%v) = %v, want %v", test.backoff, got, test.want)
+		}
+	}
+}
+
+func TestUnavailable(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{status.Error(codes.Unavailable, ""), true},
+		{status.Error(codes.DeadlineExceeded, ""), true},
+		{status.Error(codes.ResourceExhausted, ""), true},
+		{context.DeadlineExceeded, true},
+		{status.Error(codes.NotFound, ""), false},
+		{status.Error(codes.PermissionDenied, ""), false},
+		{context.Canceled, false},
+		{nil, false},
+	}
+	for _, test := range tests {
+		if got := Unavailable(test.err); got != test.want {
+			t.Errorf("Unavailable(%v) = %v, want %v", test.err, got, test.want)
+		}
+	}
+}
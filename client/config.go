@@ -0,0 +1,119 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+	"github.com/yash3004/config_server/configurations"
+	pb "github.com/yash3004/config_server/generated/protobuf/configpb"
+	"gopkg.in/yaml.v2"
+	"k8s.io/klog/v2"
+)
+
+// ErrUnsupportedFormat is returned when decoding a file whose format is not
+// known by its extension
+var ErrUnsupportedFormat = errors.New("unsupported file format")
+
+// File is the content of a configuration file
+type File struct {
+	// Owner is the namespace the file was requested from; empty for the
+	// namespace of the caller
+	Owner    string `json:"owner"`
+	Filename string `json:"filename"`
+	Data     []byte `json:"data"`
+	Revision int64  `json:"revision"`
+	ETag     string `json:"etag"`
+	// Stale is set when the server could not be reached and the file is
+	// the last known good copy from the cache
+	Stale bool `json:"-"`
+}
+
+// clone returns a copy of f that shares no memory with it
+func (f *File) clone() *File {
+	file := *f
+	file.Data = append([]byte(nil), f.Data...)
+	return &file
+}
+
+// Decode unmarshals the content of a JSON, YAML, TOML, XML, INI,
+// properties, .env or HCL file into v. INI, properties, .env and HCL files
+// are read like the server reads them and then decoded into v as JSON, so
+// the json struct tags of v apply; their values are strings except in HCL.
+func (f *File) Decode(v interface{}) error {
+	switch strings.ToLower(path.Ext(f.Filename)) {
+	case ".json":
+		return json.Unmarshal(f.Data, v)
+	case ".yaml", ".yml":
+		return yaml.Unmarshal(f.Data, v)
+	case ".toml":
+		return toml.Unmarshal(f.Data, v)
+	case ".xml":
+		return xml.Unmarshal(f.Data, v)
+	}
+
+	switch fileType := configurations.FileTypeFromExtension(f.Filename); fileType {
+	case configurations.FileTypeINI, configurations.FileTypeProperties, configurations.FileTypeEnv, configurations.FileTypeHCL:
+		doc, err := configurations.DecodeDocument(fileType, f.Data)
+		if err != nil {
+			return err
+		}
+		data, err := json.Marshal(doc)
+		if err != nil {
+			return err
+		}
+		return json.Unmarshal(data, v)
+	}
+	return fmt.Errorf("%w: %s", ErrUnsupportedFormat, f.Filename)
+}
+
+// Get reads the latest revision of a file from the namespace of owner, or
+// of the caller when owner is empty. If the server stays unreachable after
+// retrying, the last known good copy is returned with Stale set.
+func (c *Client) Get(ctx context.Context, owner, filename string) (*File, error) {
+	var resp *pb.GetConfigResponse
+	err := c.retry(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = c.service.GetConfig(ctx, &pb.GetConfig{Owner: owner, Filename: filename})
+		return err
+	})
+	if err != nil {
+		if Unavailable(err) {
+			if file, ok := c.cache.get(owner, filename); ok {
+				klog.FromContext(ctx).Info("config server unavailable, using cached file", "filename", filename, "revision", file.Revision, "err", err)
+				file.Stale = true
+				return file, nil
+			}
+		}
+		return nil, err
+	}
+
+	file := &File{
+		Owner:    owner,
+		Filename: filename,
+		Data:     resp.GetData(),
+		Revision: resp.GetRevision(),
+		ETag:     resp.GetEtag(),
+	}
+	if err := c.cache.put(file); err != nil {
+		klog.FromContext(ctx).Error(err, "cannot cache file", "filename", filename)
+	}
+	return file, nil
+}
+
+// GetInto reads a file like Get and decodes its content into v
+func (c *Client) GetInto(ctx context.Context, owner, filename string, v interface{}) (*File, error) {
+	file, err := c.Get(ctx, owner, filename)
+	if err != nil {
+		return nil, err
+	}
+	if err := file.Decode(v); err != nil {
+		return nil, err
+	}
+	return file, nil
+}
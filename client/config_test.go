@@ -0,0 +1,94 @@
+package client
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestFileDecode(t *testing.T) {
+	type database struct {
+		Host string `json:"host" yaml:"host" toml:"host" xml:"host"`
+		Port string `json:"port" yaml:"port" toml:"port" xml:"port"`
+	}
+	type config struct {
+		Name     string   `json:"name" yaml:"name" toml:"name" xml:"name"`
+		Database database `json:"database" yaml:"database" toml:"database" xml:"database"`
+	}
+	want := config{Name: "app", Database: database{Host: "db", Port: "5432"}}
+	tests := []struct {
+		filename string
+		data     string
+	}{
+		{"app.json", `{"name": "app", "database": {"host": "db", "port": "5432"}}`},
+		{"app.yaml", "name: app\ndatabase:\n  host: db\n  port: \"5432\"\n"},
+		{"app.toml", "name = \"app\"\n[database]\nhost = \"db\"\nport = \"5432\"\n"},
+		{"app.xml", "<config><name>app</name><database><host>db</host><port>5432</port></database></config>"},
+		{"app.ini", "name = app\n; comment\n[database]\nhost = db\nport = 5432\n"},
+		{"app.hcl", "name = \"app\"\ndatabase {\n  host = \"db\"\n  port = \"5432\"\n}\n"},
+		{"APP.TF", "name = \"app\"\ndatabase {\n  host = \"db\"\n  port = \"5432\"\n}\n"},
+	}
+	for _, test := range tests {
+		t.Run(test.filename, func(t *testing.T) {
+			var got config
+			file := &File{Filename: test.filename, Data: []byte(test.data)}
+			if err := file.Decode(&got); err != nil {
+				t.Fatal(err)
+			}
+			if got != want {
+				t.Errorf("got %+v, want %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestFileDecodeKeyValues(t *testing.T) {
+	tests := []struct {
+		filename string
+		data     string
+		want     map[string]string
+	}{
+		{"app.properties", "db.host=db\ndb.port : 5432\n# comment\n", map[string]string{"db.host": "db", "db.port": "5432"}},
+		{".env", "DB_HOST=db\nexport DB_PORT='5432'\n", map[string]string{"DB_HOST": "db", "DB_PORT": "5432"}},
+		{"prod.env", "DB_HOST=db\n", map[string]string{"DB_HOST": "db"}},
+	}
+	for _, test := range tests {
+		t.Run(test.filename, func(t *testing.T) {
+			var got map[string]string
+			file := &File{Filename: test.filename, Data: []byte(test.data)}
+			if err := file.Decode(&got); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestFileDecodeErrors(t *testing.T) {
+	tests := []struct {
+		filename string
+		data     string
+	}{
+		{"app.ini", "[database\nhost = db\n"},
+		{"app.properties", "key=\\u12\n"},
+		{".env", "not a variable\n"},
+		{"app.hcl", "name = \n"},
+	}
+	for _, test := range tests {
+		t.Run(test.filename, func(t *testing.T) {
+			var got map[string]interface{}
+			file := &File{Filename: test.filename, Data: []byte(test.data)}
+			if err := file.Decode(&got); err == nil {
+				t.Errorf("decoded %v", got)
+			}
+		})
+	}
+
+	var got map[string]interface{}
+	file := &File{Filename: "notes.txt", Data: []byte("text")}
+	if err := file.Decode(&got); !errors.Is(err, ErrUnsupportedFormat) {
+		t.Errorf("decoding a text file = %v, want %v", err, ErrUnsupportedFormat)
+	}
+}
@@ -0,0 +1,261 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	pb "github.com/yash3004/config_server/generated/protobuf/configpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+// EventType is the kind of change an Event describes
+type EventType int
+
+const (
+	// EventUpdated reports the new content of a file
+	EventUpdated EventType = iota + 1
+	// EventDeleted reports that a file was deleted or moved away
+	EventDeleted
+	// EventSynced is sent whenever the watcher has received the current
+	// state of the watched files, after connecting and after reconnecting
+	EventSynced
+)
+
+// Event is a change of a watched file
+type Event struct {
+	Type EventType
+	// File is the new content of updated files. Deleted files only carry
+	// their owner and filename; it is nil for EventSynced.
+	File *File
+}
+
+// WatchOptions selects the files of a watch
+type WatchOptions struct {
+	// Owner is the namespace of the files; empty for the caller's own
+	Owner string
+	// Filename watches a single file. Prefix is ignored when it is set.
+	Filename string
+	// Prefix watches every file whose name starts with it
+	Prefix string
+	// SinceRevision starts a file watch with the revisions after it
+	// instead of the latest revision
+	SinceRevision int64
+}
+
+// handlerError wraps the error of a watch handler so it ends the watch
+// instead of reconnecting
+type handlerError struct {
+	err error
+}
+
+func (e *handlerError) Error() string {
+	return e.err.Error()
+}
+
+func (e *handlerError) Unwrap() error {
+	return e.err
+}
+
+// watcher tracks what the handler of a watch has been told so streams can
+// be reopened without reporting anything twice
+type watcher struct {
+	client  *Client
+	opts    WatchOptions
+	handler func(*Event) error
+	// known holds the latest revision reported of every existing file
+	known map[string]knownFile
+}
+
+// knownFile is the revision of a file that was reported to the handler
+type knownFile struct {
+	revision int64
+	etag     string
+}
+
+// Watch calls handler with the changes of the files selected by opts until
+// ctx is done or handler returns an error, which Watch then returns. Broken
+// streams are reopened with backoff. After reopening, the latest revision of
+// every file that changed in the meantime is reported, together with the
+// deletions that were missed. While a file watch cannot reach the server it
+// starts with the cached copy of the file, marked as stale.
+func (c *Client) Watch(ctx context.Context, opts WatchOptions, handler func(*Event) error) error {
+	w := &watcher{
+		client:  c,
+		opts:    opts,
+		handler: handler,
+		known:   make(map[string]knownFile),
+	}
+
+	since := opts.SinceRevision
+	backoff := c.opts.InitialBackoff
+	everSynced := false
+	for {
+		synced, err := w.stream(ctx, since)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		var handlerErr *handlerError
+		if errors.As(err, &handlerErr) {
+			return handlerErr.err
+		}
+		if !resumable(err) {
+			return err
+		}
+
+		if synced {
+			everSynced = true
+			backoff = c.opts.InitialBackoff
+		} else if !everSynced {
+			if err := w.fallback(); err != nil {
+				return errors.Unwrap(err)
+			}
+		}
+		// Later streams start with the latest revisions; known filters
+		// what was already reported
+		since = 0
+
+		klog.FromContext(ctx).Info("watch interrupted, reconnecting", "filename", opts.Filename, "prefix", opts.Prefix, "err", err, "backoff", backoff)
+		if err := c.sleep(ctx, backoff); err != nil {
+			return err
+		}
+		backoff = c.nextBackoff(backoff)
+	}
+}
+
+// stream reads a single watch stream until it fails and reports whether it
+// delivered the current state of the watched files
+func (w *watcher) stream(ctx context.Context, since int64) (synced bool, err error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := w.client.service.WatchConfig(ctx, &pb.WatchConfig{
+		Owner:         w.opts.Owner,
+		Filename:      w.opts.Filename,
+		Prefix:        w.opts.Prefix,
+		SinceRevision: since,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	// present collects the files of the initial state of the stream
+	present := make(map[string]bool)
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			return synced, err
+		}
+
+		switch event.GetType() {
+		case pb.EventType_EVENT_TYPE_UPDATED:
+			if !synced {
+				present[event.GetFilename()] = true
+			}
+			err = w.update(ctx, event, !synced)
+		case pb.EventType_EVENT_TYPE_DELETED:
+			err = w.delete(ctx, event.GetFilename())
+		case pb.EventType_EVENT_TYPE_SYNCED:
+			if synced {
+				continue
+			}
+			synced = true
+			// Files reported before the stream was reopened that are
+			// missing from its initial state were deleted in the meantime
+			for filename := range w.known {
+				if !present[filename] {
+					if err = w.delete(ctx, filename); err != nil {
+						break
+					}
+				}
+			}
+			if err == nil {
+				err = w.deliver(&Event{Type: EventSynced})
+			}
+		}
+		if err != nil {
+			return synced, err
+		}
+	}
+}
+
+// update reports a new revision of a file unless it was reported before.
+// The initial state of a stream is compared by content: a file deleted and
+// created again while the stream was down starts over at a lower revision.
+func (w *watcher) update(ctx context.Context, event *pb.ConfigEvent, initial bool) error {
+	revision := event.GetRevision()
+	current := knownFile{revision: revision.GetRevision(), etag: revision.GetHash()}
+	if known, ok := w.known[event.GetFilename()]; ok {
+		if initial && current.etag == known.etag {
+			w.known[event.GetFilename()] = current
+			return nil
+		}
+		if !initial && current.revision <= known.revision {
+			return nil
+		}
+	}
+	w.known[event.GetFilename()] = current
+
+	file := &File{
+		Owner:    w.opts.Owner,
+		Filename: event.GetFilename(),
+		Data:     event.GetData(),
+		Revision: revision.GetRevision(),
+		ETag:     revision.GetHash(),
+	}
+	if err := w.client.cache.put(file); err != nil {
+		klog.FromContext(ctx).Error(err, "cannot cache file", "filename", file.Filename)
+	}
+	return w.deliver(&Event{Type: EventUpdated, File: file})
+}
+
+// delete reports the deletion of a file that was reported before
+func (w *watcher) delete(ctx context.Context, filename string) error {
+	if _, ok := w.known[filename]; !ok {
+		return nil
+	}
+	delete(w.known, filename)
+
+	if err := w.client.cache.remove(w.opts.Owner, filename); err != nil {
+		klog.FromContext(ctx).Error(err, "cannot remove cached file", "filename", filename)
+	}
+	return w.deliver(&Event{
+		Type: EventDeleted,
+		File: &File{Owner: w.opts.Owner, Filename: filename},
+	})
+}
+
+// fallback reports the cached copy of a watched file while the server is
+// unreachable
+func (w *watcher) fallback() error {
+	if w.opts.Filename == "" {
+		return nil
+	}
+	if _, ok := w.known[w.opts.Filename]; ok {
+		return nil
+	}
+	file, ok := w.client.cache.get(w.opts.Owner, w.opts.Filename)
+	if !ok {
+		return nil
+	}
+
+	w.known[file.Filename] = knownFile{revision: file.Revision, etag: file.ETag}
+	file.Stale = true
+	return w.deliver(&Event{Type: EventUpdated, File: file})
+}
+
+// deliver passes an event to the handler
+func (w *watcher) deliver(event *Event) error {
+	if err := w.handler(event); err != nil {
+		return &handlerError{err: err}
+	}
+	return nil
+}
+
+// resumable reports whether a watch stream ended in a way that reopening
+// it can recover from
+func resumable(err error) bool {
+	return errors.Is(err, io.EOF) || Unavailable(err) || status.Code(err) == codes.Aborted
+}
@@ -0,0 +1,318 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"testing"
+
+	pb "github.com/yash3004/config_server/generated/protobuf/configpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeStream replays events and then fails with err
+type fakeStream struct {
+	grpc.ClientStream
+	events []*pb.ConfigEvent
+	err    error
+}
+
+func (s *fakeStream) Recv() (*pb.ConfigEvent, error) {
+	if len(s.events) == 0 {
+		return nil, s.err
+	}
+	event := s.events[0]
+	s.events = s.events[1:]
+	return event, nil
+}
+
+// fakeConnection is the outcome of opening one watch stream: either the
+// error of the call or the events of the stream, which then ends with EOF
+type fakeConnection struct {
+	err    error
+	events []*pb.ConfigEvent
+}
+
+// errWatchDone ends a watch once the fake service has no streams left
+var errWatchDone = status.Error(codes.PermissionDenied, "no more streams")
+
+func (s *fakeService) WatchConfig(ctx context.Context, in *pb.WatchConfig, opts ...grpc.CallOption) (pb.ConfigService_WatchConfigClient, error) {
+	s.requests = append(s.requests, in)
+	if len(s.connections) == 0 {
+		return nil, errWatchDone
+	}
+	connection := s.connections[0]
+	s.connections = s.connections[1:]
+	if connection.err != nil {
+		return nil, connection.err
+	}
+	return &fakeStream{events: connection.events, err: io.EOF}, nil
+}
+
+func updated(filename string, revision int64, etag string) *pb.ConfigEvent {
+	return &pb.ConfigEvent{
+		Type:     pb.EventType_EVENT_TYPE_UPDATED,
+		Filename: filename,
+		Data:     []byte(etag),
+		Revision: &pb.Revision{Revision: revision, Hash: etag},
+	}
+}
+
+func deleted(filename string) *pb.ConfigEvent {
+	return &pb.ConfigEvent{Type: pb.EventType_EVENT_TYPE_DELETED, Filename: filename}
+}
+
+func synced() *pb.ConfigEvent {
+	return &pb.ConfigEvent{Type: pb.EventType_EVENT_TYPE_SYNCED}
+}
+
+// describe summarizes an event as "updated a@2 e2", "deleted a" or "synced"
+func describe(event *Event) string {
+	switch event.Type {
+	case EventUpdated:
+		s := fmt.Sprintf("updated %s@%d %s", event.File.Filename, event.File.Revision, event.File.ETag)
+		if event.File.Stale {
+			s += " stale"
+		}
+		return s
+	case EventDeleted:
+		return "deleted " + event.File.Filename
+	case EventSynced:
+		return "synced"
+	}
+	return fmt.Sprintf("unknown %d", event.Type)
+}
+
+// watchEvents runs a watch against connections until the fake service runs
+// out of them and returns the events passed to the handler
+func watchEvents(t *testing.T, c *Client, opts WatchOptions) []string {
+	t.Helper()
+	var events []string
+	err := c.Watch(context.Background(), opts, func(event *Event) error {
+		events = append(events, describe(event))
+		return nil
+	})
+	if !errors.Is(err, errWatchDone) {
+		t.Fatalf("Watch returned %v, want %v", err, errWatchDone)
+	}
+	return events
+}
+
+func TestWatchReconnects(t *testing.T) {
+	tests := []struct {
+		name        string
+		opts        WatchOptions
+		connections []fakeConnection
+		want        []string
+	}{
+		{
+			name: "initial state already reported is skipped",
+			connections: []fakeConnection{
+				{events: []*pb.ConfigEvent{updated("a", 1, "e1"), synced(), updated("a", 2, "e2")}},
+				{events: []*pb.ConfigEvent{updated("a", 2, "e2"), synced()}},
+			},
+			want: []string{"updated a@1 e1", "synced", "updated a@2 e2", "synced"},
+		},
+		{
+			name: "changes missed while disconnected are reported once",
+			connections: []fakeConnection{
+				{events: []*pb.ConfigEvent{updated("a", 1, "e1"), updated("b", 1, "e1"), synced()}},
+				{events: []*pb.ConfigEvent{updated("a", 3, "e3"), updated("b", 1, "e1"), synced()}},
+			},
+			want: []string{"updated a@1 e1", "updated b@1 e1", "synced", "updated a@3 e3", "synced"},
+		},
+		{
+			name: "files missing from the initial state were deleted",
+			connections: []fakeConnection{
+				{events: []*pb.ConfigEvent{updated("a", 1, "e1"), updated("b", 1, "e1"), synced()}},
+				{events: []*pb.ConfigEvent{updated("a", 1, "e1"), synced()}},
+			},
+			want: []string{"updated a@1 e1", "updated b@1 e1", "synced", "deleted b", "synced"},
+		},
+		{
+			name: "a file recreated while disconnected is reported at its lower revision",
+			connections: []fakeConnection{
+				{events: []*pb.ConfigEvent{updated("a", 3, "e3"), synced()}},
+				{events: []*pb.ConfigEvent{updated("a", 1, "new"), synced()}},
+			},
+			want: []string{"updated a@3 e3", "synced", "updated a@1 new", "synced"},
+		},
+		{
+			name: "a stream that breaks before syncing reports nothing twice",
+			connections: []fakeConnection{
+				{events: []*pb.ConfigEvent{updated("a", 1, "e1"), updated("b", 1, "e1")}},
+				{events: []*pb.ConfigEvent{updated("a", 1, "e1"), updated("b", 2, "e2"), synced()}},
+			},
+			want: []string{"updated a@1 e1", "updated b@1 e1", "updated b@2 e2", "synced"},
+		},
+		{
+			name: "unavailable and aborted streams are reopened",
+			connections: []fakeConnection{
+				{err: status.Error(codes.Unavailable, "down")},
+				{err: status.Error(codes.Aborted, "restarting")},
+				{events: []*pb.ConfigEvent{updated("a", 1, "e1"), synced()}},
+			},
+			want: []string{"updated a@1 e1", "synced"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := newTestClient(&fakeService{connections: test.connections}, "")
+			if got := watchEvents(t, c, test.opts); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("events = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestWatchLiveEvents(t *testing.T) {
+	tests := []struct {
+		name   string
+		events []*pb.ConfigEvent
+		want   []string
+	}{
+		{
+			name:   "older and repeated revisions are skipped",
+			events: []*pb.ConfigEvent{updated("a", 2, "e2"), synced(), updated("a", 1, "e1"), updated("a", 2, "e2"), updated("a", 3, "e3")},
+			want:   []string{"updated a@2 e2", "synced", "updated a@3 e3"},
+		},
+		{
+			name:   "deletions of files never reported are skipped",
+			events: []*pb.ConfigEvent{deleted("x"), updated("a", 1, "e1"), synced(), deleted("a"), deleted("a")},
+			want:   []string{"updated a@1 e1", "synced", "deleted a"},
+		},
+		{
+			name:   "a file created again after its deletion is reported",
+			events: []*pb.ConfigEvent{synced(), updated("a", 4, "e4"), deleted("a"), updated("a", 1, "new")},
+			want:   []string{"synced", "updated a@4 e4", "deleted a", "updated a@1 new"},
+		},
+		{
+			name:   "repeated sync events are skipped",
+			events: []*pb.ConfigEvent{synced(), synced(), updated("a", 1, "e1")},
+			want:   []string{"synced", "updated a@1 e1"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := newTestClient(&fakeService{connections: []fakeConnection{{events: test.events}}}, "")
+			if got := watchEvents(t, c, WatchOptions{}); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("events = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestWatchResumesOnlyTheFirstStream(t *testing.T) {
+	service := &fakeService{connections: []fakeConnection{
+		{events: []*pb.ConfigEvent{updated("a", 6, "e6"), synced()}},
+		{events: []*pb.ConfigEvent{synced()}},
+	}}
+	opts := WatchOptions{Owner: "team:ops", Filename: "a", SinceRevision: 5}
+	watchEvents(t, newTestClient(service, ""), opts)
+
+	want := []int64{5, 0, 0}
+	if len(service.requests) != len(want) {
+		t.Fatalf("%d requests, want %d", len(service.requests), len(want))
+	}
+	for i, request := range service.requests {
+		if request.GetSinceRevision() != want[i] || request.GetOwner() != opts.Owner || request.GetFilename() != opts.Filename {
+			t.Errorf("request %d = %v, want since_revision %d", i, request, want[i])
+		}
+	}
+}
+
+func TestWatchFallsBackToTheCache(t *testing.T) {
+	down := fakeConnection{err: status.Error(codes.Unavailable, "down")}
+	tests := []struct {
+		name        string
+		opts        WatchOptions
+		connections []fakeConnection
+		want        []string
+	}{
+		{
+			name:        "stale copy once, then the server's revision",
+			opts:        WatchOptions{Filename: "a"},
+			connections: []fakeConnection{down, down, {events: []*pb.ConfigEvent{updated("a", 2, "e2"), synced()}}},
+			want:        []string{"updated a@1 e1 stale", "updated a@2 e2", "synced"},
+		},
+		{
+			name:        "the stale copy is not reported again",
+			opts:        WatchOptions{Filename: "a"},
+			connections: []fakeConnection{down, {events: []*pb.ConfigEvent{updated("a", 1, "e1"), synced()}}},
+			want:        []string{"updated a@1 e1 stale", "synced"},
+		},
+		{
+			name:        "no fallback once the stream has synced",
+			opts:        WatchOptions{Filename: "a"},
+			connections: []fakeConnection{{events: []*pb.ConfigEvent{synced()}}, down},
+			want:        []string{"synced"},
+		},
+		{
+			name:        "no fallback for prefix watches",
+			opts:        WatchOptions{Prefix: "a"},
+			connections: []fakeConnection{down, {events: []*pb.ConfigEvent{synced()}}},
+			want:        []string{"synced"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := newTestClient(&fakeService{connections: test.connections}, "")
+			if err := c.cache.put(&File{Filename: "a", Revision: 1, ETag: "e1"}); err != nil {
+				t.Fatal(err)
+			}
+			if got := watchEvents(t, c, test.opts); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("events = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestWatchKeepsTheCacheCurrent(t *testing.T) {
+	c := newTestClient(&fakeService{connections: []fakeConnection{
+		{events: []*pb.ConfigEvent{updated("a", 1, "e1"), updated("b", 1, "e1"), synced(), updated("a", 2, "e2"), deleted("b")}},
+	}}, "")
+	watchEvents(t, c, WatchOptions{})
+
+	if file, ok := c.cache.get("", "a"); !ok || file.Revision != 2 {
+		t.Errorf("cached a = %+v, %v; want revision 2", file, ok)
+	}
+	if _, ok := c.cache.get("", "b"); ok {
+		t.Error("deleted file b is still cached")
+	}
+}
+
+func TestWatchHandlerError(t *testing.T) {
+	errStop := errors.New("stop")
+	tests := []struct {
+		name   string
+		stopAt int
+	}{
+		{name: "on an update", stopAt: 1},
+		{name: "on the sync", stopAt: 2},
+		{name: "on a deletion found when reconnecting", stopAt: 4},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := newTestClient(&fakeService{connections: []fakeConnection{
+				{events: []*pb.ConfigEvent{updated("a", 1, "e1"), synced()}},
+				{events: []*pb.ConfigEvent{updated("b", 1, "e1"), synced()}},
+			}}, "")
+
+			calls := 0
+			err := c.Watch(context.Background(), WatchOptions{}, func(event *Event) error {
+				calls++
+				if calls == test.stopAt {
+					return errStop
+				}
+				return nil
+			})
+			if err != errStop || calls != test.stopAt {
+				t.Errorf("Watch returned %v after %d events, want %v after %d", err, calls, errStop, test.stopAt)
+			}
+		})
+	}
+}
@@ -0,0 +1,272 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yash3004/config_server/client"
+	"k8s.io/klog/v2"
+)
+
+// agent mirrors the watched files into the output directory
+type agent struct {
+	config *agentConfig
+	client *client.Client
+	// changed receives the local paths whose content changed; nil when
+	// no hooks run
+	changed chan string
+}
+
+func newAgent(config *agentConfig, c *client.Client) *agent {
+	return &agent{config: config, client: c}
+}
+
+// run watches every file spec until ctx is done or a watch fails. With
+// once set it returns after each watch wrote its current files, without
+// running hooks.
+func (a *agent) run(ctx context.Context, once bool) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	if !once {
+		a.changed = make(chan string, 64)
+		go a.runHooks(ctx)
+	}
+
+	errs := make(chan error, len(a.config.Files))
+	for _, spec := range a.config.Files {
+		spec := spec
+		go func() {
+			errs <- a.watch(ctx, spec, once)
+		}()
+	}
+
+	for range a.config.Files {
+		if err := <-errs; err != nil {
+			return err
+		}
+		if !once {
+			// Watches only end early if they fail
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// watch writes the files selected by spec whenever they change
+func (a *agent) watch(ctx context.Context, spec fileSpec, once bool) error {
+	watchCtx, stop := context.WithCancel(ctx)
+	defer stop()
+
+	opts := client.WatchOptions{Owner: spec.Owner, Filename: spec.Filename, Prefix: spec.Prefix}
+	err := a.client.Watch(watchCtx, opts, func(event *client.Event) error {
+		switch event.Type {
+		case client.EventUpdated:
+			if err := a.write(spec, event.File); err != nil {
+				return err
+			}
+			// A stale copy is all a file watch gets while the server is
+			// unreachable, which is good enough to start the application
+			if once && event.File.Stale {
+				stop()
+			}
+		case client.EventDeleted:
+			if err := a.remove(spec, event.File.Filename); err != nil {
+				return err
+			}
+		case client.EventSynced:
+			if once {
+				stop()
+			}
+		}
+		return nil
+	})
+
+	if once && watchCtx.Err() != nil && ctx.Err() == nil {
+		return nil
+	}
+	return err
+}
+
+// localPath returns where a file of a spec is written. Files of a prefix
+// keep their names relative to the directory of the prefix, so "app/db-"
+// writes "app/db-main.yaml" as "db-main.yaml" below the path of the spec.
+func (a *agent) localPath(spec fileSpec, filename string) (string, error) {
+	rel := spec.Path
+	if spec.Prefix != "" {
+		rel = path.Join(rel, strings.TrimPrefix(filename, prefixDir(spec.Prefix)))
+	}
+
+	local := filepath.Join(a.config.OutputDir, filepath.FromSlash(rel))
+	if r, err := filepath.Rel(a.config.OutputDir, local); err != nil || r == "." || strings.HasPrefix(r, "..") {
+		return "", fmt.Errorf("%s maps outside of the output directory", filename)
+	}
+	return local, nil
+}
+
+// prefixDir returns the directory part of a prefix with its trailing slash,
+// or the empty string for prefixes at the top of the namespace
+func prefixDir(prefix string) string {
+	return prefix[:strings.LastIndex(prefix, "/")+1]
+}
+
+// write replaces a local file with new content by renaming a temporary
+// file over it, so readers never see partial content. Unchanged files are
+// left alone.
+func (a *agent) write(spec fileSpec, file *client.File) error {
+	local, err := a.localPath(spec, file.Filename)
+	if err != nil {
+		// The other files of the watch can still be written
+		klog.ErrorS(err, "skipping file", "filename", file.Filename)
+		return nil
+	}
+
+	current, err := os.ReadFile(local)
+	if err == nil && bytes.Equal(current, file.Data) {
+		return nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(local), 0755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(local), "."+filepath.Base(local)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	_, err = tmp.Write(file.Data)
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tmp.Name(), fs.FileMode(spec.Mode))
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), local)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	klog.InfoS("wrote file", "filename", file.Filename, "revision", file.Revision, "path", local, "stale", file.Stale)
+	a.notify(local)
+	return nil
+}
+
+// remove deletes the local copy of a file that was deleted on the server
+func (a *agent) remove(spec fileSpec, filename string) error {
+	local, err := a.localPath(spec, filename)
+	if err != nil {
+		klog.ErrorS(err, "skipping file", "filename", filename)
+		return nil
+	}
+	if err := os.Remove(local); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+
+	klog.InfoS("removed file", "filename", filename, "path", local)
+	a.notify(local)
+	return nil
+}
+
+// notify queues a changed path for the change hook
+func (a *agent) notify(local string) {
+	if a.changed != nil {
+		a.changed <- local
+	}
+}
+
+// runHooks runs the change hook once the changes of a burst have settled
+func (a *agent) runHooks(ctx context.Context) {
+	hook := a.config.OnChange
+	pending := make(map[string]bool)
+	timer := time.NewTimer(0)
+	<-timer.C
+
+	for {
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case local := <-a.changed:
+			pending[local] = true
+			timer.Reset(hook.Debounce)
+		case <-timer.C:
+			paths := make([]string, 0, len(pending))
+			for local := range pending {
+				paths = append(paths, local)
+			}
+			sort.Strings(paths)
+			pending = make(map[string]bool)
+
+			if err := a.runHook(ctx, paths); err != nil {
+				klog.ErrorS(err, "change hook failed", "paths", paths)
+			}
+		}
+	}
+}
+
+// runHook signals the application and runs the hook command
+func (a *agent) runHook(ctx context.Context, paths []string) error {
+	hook := a.config.OnChange
+
+	if hook.Signal != "" {
+		if err := signalProcess(hook.PIDFile, signals[strings.ToUpper(hook.Signal)]); err != nil {
+			return err
+		}
+		klog.InfoS("signaled application", "signal", hook.Signal, "pidFile", hook.PIDFile)
+	}
+
+	if len(hook.Command) > 0 {
+		ctx, cancel := context.WithTimeout(ctx, hook.Timeout)
+		defer cancel()
+
+		cmd := exec.CommandContext(ctx, hook.Command[0], hook.Command[1:]...)
+		cmd.Env = append(os.Environ(), "CONFIG_AGENT_CHANGED="+strings.Join(paths, " "))
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		if err := cmd.Run(); err != nil {
+			return fmt.Errorf("command %q: %w", hook.Command, err)
+		}
+		klog.InfoS("ran change command", "command", hook.Command)
+	}
+	return nil
+}
+
+// signalProcess sends sig to the process whose ID is stored in pidFile
+func signalProcess(pidFile string, sig os.Signal) error {
+	data, err := os.ReadFile(pidFile)
+	if err != nil {
+		return err
+	}
+	pid, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil {
+		return fmt.Errorf("invalid pid file %s: %w", pidFile, err)
+	}
+
+	process, err := os.FindProcess(pid)
+	if err == nil {
+		err = process.Signal(sig)
+	}
+	if err != nil {
+		return fmt.Errorf("signal process %d: %w", pid, err)
+	}
+	return nil
+}
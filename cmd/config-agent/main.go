@@ -0,0 +1,182 @@
+// config-agent watches configuration files on the config server and writes
+// them into a local directory, so applications that only read local files
+// can consume them. It can signal the application or run a command whenever
+// a file changes.
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"path"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/yash3004/config_server/client"
+	"gopkg.in/yaml.v2"
+	"k8s.io/klog/v2"
+)
+
+const (
+	defaultFileMode    = 0644
+	defaultDebounce    = time.Second
+	defaultHookTimeout = 30 * time.Second
+)
+
+// agentConfig is the configuration file of the agent
+type agentConfig struct {
+	// Server is the address of the gRPC API as host:port
+	Server string `yaml:"server"`
+	TLS    bool   `yaml:"tls"`
+	// Token is a bearer token or API key. CONFIG_AGENT_TOKEN and the
+	// content of TokenFile take precedence.
+	Token     string `yaml:"token"`
+	TokenFile string `yaml:"token_file"`
+	// CacheDir keeps the last known good content of the files so they
+	// can be written while the server is unreachable
+	CacheDir string `yaml:"cache_dir"`
+	// OutputDir is the directory the files are written to
+	OutputDir string     `yaml:"output_dir"`
+	Files     []fileSpec `yaml:"files"`
+	OnChange  changeHook `yaml:"on_change"`
+}
+
+// fileSpec selects the files of a single watch
+type fileSpec struct {
+	// Owner is the namespace of the files; empty for the agent's own
+	Owner string `yaml:"owner"`
+	// Filename watches a single file
+	Filename string `yaml:"filename"`
+	// Prefix watches every file whose name starts with it
+	Prefix string `yaml:"prefix"`
+	// Path is where a single file is written, or the directory the files
+	// of a prefix are mirrored into, relative to the output directory.
+	// It defaults to the filename or the directory of the prefix.
+	Path string `yaml:"path"`
+	// Mode is the permission of the written files, 0644 by default
+	Mode uint32 `yaml:"mode"`
+}
+
+// changeHook is what the agent does after files changed
+type changeHook struct {
+	// Signal is sent to the process whose ID is stored in PIDFile, e.g.
+	// SIGHUP
+	Signal  string `yaml:"signal"`
+	PIDFile string `yaml:"pid_file"`
+	// Command is run with CONFIG_AGENT_CHANGED listing the changed paths
+	Command []string `yaml:"command"`
+	// Debounce waits for further changes before running the hook, so a
+	// burst of changes runs it once
+	Debounce time.Duration `yaml:"debounce"`
+	// Timeout bounds the runtime of Command
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+// signals maps the supported signal names to signals
+var signals = map[string]syscall.Signal{
+	"SIGHUP":  syscall.SIGHUP,
+	"SIGINT":  syscall.SIGINT,
+	"SIGTERM": syscall.SIGTERM,
+	"SIGUSR1": syscall.SIGUSR1,
+	"SIGUSR2": syscall.SIGUSR2,
+}
+
+func main() {
+	klog.InitFlags(nil)
+	configPath := flag.String("cfg", "agent.yaml", "agent configuration file")
+	once := flag.Bool("once", false, "write the files once and exit, e.g. in an init container")
+	flag.Parse()
+
+	config, err := loadConfig(*configPath)
+	if err != nil {
+		klog.Fatalf("cannot load configuration: %v", err)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	opts := client.Options{Token: config.Token, CacheDir: config.CacheDir}
+	if config.TLS {
+		opts.TLS = &tls.Config{}
+	}
+	c, err := client.New(config.Server, opts)
+	if err != nil {
+		klog.Fatalf("cannot connect to %s: %v", config.Server, err)
+	}
+	defer c.Close()
+
+	a := newAgent(config, c)
+	if err := a.run(ctx, *once); err != nil && !errors.Is(err, context.Canceled) {
+		klog.Fatalf("agent failed: %v", err)
+	}
+}
+
+// loadConfig reads and validates the configuration file and applies the
+// defaults
+func loadConfig(configPath string) (*agentConfig, error) {
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		return nil, err
+	}
+	config := &agentConfig{}
+	if err := yaml.UnmarshalStrict(data, config); err != nil {
+		return nil, err
+	}
+
+	if token := os.Getenv("CONFIG_AGENT_TOKEN"); token != "" {
+		config.Token = token
+	} else if config.TokenFile != "" {
+		token, err := os.ReadFile(config.TokenFile)
+		if err != nil {
+			return nil, err
+		}
+		config.Token = strings.TrimSpace(string(token))
+	}
+
+	if config.Server == "" {
+		return nil, errors.New("server is required")
+	}
+	if config.OutputDir == "" {
+		return nil, errors.New("output_dir is required")
+	}
+	if len(config.Files) == 0 {
+		return nil, errors.New("no files to watch")
+	}
+	for i := range config.Files {
+		spec := &config.Files[i]
+		if (spec.Filename == "") == (spec.Prefix == "") {
+			return nil, fmt.Errorf("files[%d]: exactly one of filename and prefix is required", i)
+		}
+		if spec.Path == "" {
+			spec.Path = spec.Filename + prefixDir(spec.Prefix)
+		}
+		if path.IsAbs(spec.Path) || strings.HasPrefix(path.Clean(spec.Path), "..") {
+			return nil, fmt.Errorf("files[%d]: path must be inside output_dir", i)
+		}
+		if spec.Mode == 0 {
+			spec.Mode = defaultFileMode
+		}
+	}
+
+	hook := &config.OnChange
+	if hook.Signal != "" {
+		if _, ok := signals[strings.ToUpper(hook.Signal)]; !ok {
+			return nil, fmt.Errorf("unsupported signal %q", hook.Signal)
+		}
+		if hook.PIDFile == "" {
+			return nil, errors.New("on_change.signal requires on_change.pid_file")
+		}
+	}
+	if hook.Debounce == 0 {
+		hook.Debounce = defaultDebounce
+	}
+	if hook.Timeout == 0 {
+		hook.Timeout = defaultHookTimeout
+	}
+	return config, nil
+}
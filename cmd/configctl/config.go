@@ -0,0 +1,216 @@
+package main
+
+import (
+	"crypto/tls"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/yash3004/config_server/client"
+	"gopkg.in/yaml.v2"
+)
+
+// ctlConfig is the configuration file of configctl
+type ctlConfig struct {
+	CurrentContext string                 `yaml:"current_context"`
+	Contexts       map[string]*ctlContext `yaml:"contexts"`
+}
+
+// ctlContext is a server configctl talks to together with the credentials
+// used for it
+type ctlContext struct {
+	Server string `yaml:"server"`
+	TLS    bool   `yaml:"tls,omitempty"`
+	// User is the user the token was issued to
+	User string `yaml:"user,omitempty"`
+	// Token is a bearer token stored by login or an API key
+	Token string `yaml:"token,omitempty"`
+}
+
+func (c *ctlContext) clientOptions() client.Options {
+	opts := client.Options{Token: c.Token, MaxAttempts: 2}
+	if c.TLS {
+		opts.TLS = &tls.Config{}
+	}
+	return opts
+}
+
+// defaultConfigPath returns $CONFIGCTL_CONFIG or config.yaml in the user
+// configuration directory
+func defaultConfigPath() string {
+	if path := os.Getenv("CONFIGCTL_CONFIG"); path != "" {
+		return path
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ".configctl.yaml"
+	}
+	return filepath.Join(dir, "configctl", "config.yaml")
+}
+
+// loadConfig reads the configuration file, which may not exist yet
+func (a *app) loadConfig() (*ctlConfig, error) {
+	if a.config != nil {
+		return a.config, nil
+	}
+
+	config := &ctlConfig{}
+	data, err := os.ReadFile(a.configPath)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("cannot parse %s: %w", a.configPath, err)
+	}
+	if config.Contexts == nil {
+		config.Contexts = make(map[string]*ctlContext)
+	}
+	a.config = config
+	return config, nil
+}
+
+// saveConfig writes the configuration file, which holds tokens and is only
+// readable by its owner
+func (a *app) saveConfig() error {
+	data, err := yaml.Marshal(a.config)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(a.configPath), 0700); err != nil {
+		return err
+	}
+	return os.WriteFile(a.configPath, data, 0600)
+}
+
+// currentContext returns the context selected with -context or the current
+// one of the configuration file
+func (a *app) currentContext() (*ctlContext, error) {
+	config, err := a.loadConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	name := a.contextName
+	if name == "" {
+		name = config.CurrentContext
+	}
+	if name == "" {
+		return nil, errors.New("no context selected; create one with: configctl context set NAME -server HOST:PORT")
+	}
+	ctx, ok := config.Contexts[name]
+	if !ok {
+		return nil, fmt.Errorf("context %q does not exist", name)
+	}
+	return ctx, nil
+}
+
+// runContext handles configctl context
+func runContext(a *app, args []string) error {
+	if len(args) == 0 {
+		return errUsage
+	}
+	config, err := a.loadConfig()
+	if err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "set":
+		flags := a.flags()
+		server := flags.String("server", "", "address of the gRPC API as HOST:PORT")
+		useTLS := flags.Bool("tls", false, "connect with TLS")
+		if err := parse(flags, args[1:], 1, 1); err != nil {
+			return err
+		}
+		name := flags.Arg(0)
+
+		ctx, ok := config.Contexts[name]
+		if !ok {
+			if *server == "" {
+				return errors.New("new contexts need a -server")
+			}
+			ctx = &ctlContext{}
+			config.Contexts[name] = ctx
+		}
+		if *server != "" {
+			ctx.Server = *server
+		}
+		flags.Visit(func(f *flag.Flag) {
+			if f.Name == "tls" {
+				ctx.TLS = *useTLS
+			}
+		})
+		if config.CurrentContext == "" {
+			config.CurrentContext = name
+		}
+		return a.saveConfig()
+
+	case "use":
+		if len(args) != 2 {
+			return errUsage
+		}
+		if _, ok := config.Contexts[args[1]]; !ok {
+			return fmt.Errorf("context %q does not exist", args[1])
+		}
+		config.CurrentContext = args[1]
+		return a.saveConfig()
+
+	case "rm":
+		if len(args) != 2 {
+			return errUsage
+		}
+		if _, ok := config.Contexts[args[1]]; !ok {
+			return fmt.Errorf("context %q does not exist", args[1])
+		}
+		delete(config.Contexts, args[1])
+		if config.CurrentContext == args[1] {
+			config.CurrentContext = ""
+		}
+		return a.saveConfig()
+
+	case "ls":
+		if err := parse(a.flags(), args[1:], 0, 0); err != nil {
+			return err
+		}
+		names := make([]string, 0, len(config.Contexts))
+		for name := range config.Contexts {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		type contextView struct {
+			Name    string `json:"name" yaml:"name"`
+			Server  string `json:"server" yaml:"server"`
+			TLS     bool   `json:"tls" yaml:"tls"`
+			User    string `json:"user,omitempty" yaml:"user,omitempty"`
+			Current bool   `json:"current" yaml:"current"`
+		}
+		views := make([]contextView, 0, len(names))
+		for _, name := range names {
+			ctx := config.Contexts[name]
+			views = append(views, contextView{
+				Name:    name,
+				Server:  ctx.Server,
+				TLS:     ctx.TLS,
+				User:    ctx.User,
+				Current: name == config.CurrentContext,
+			})
+		}
+		return a.printValue(views, func(w io.Writer) {
+			fmt.Fprintln(w, "CURRENT\tNAME\tSERVER\tUSER")
+			for _, view := range views {
+				current := ""
+				if view.Current {
+					current = "*"
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", current, view.Name, view.Server, view.User)
+			}
+		})
+	}
+	return errUsage
+}
@@ -0,0 +1,409 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+	"strconv"
+	"strings"
+
+	pb "github.com/yash3004/config_server/generated/protobuf/configpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// fileTypes maps file extensions to the file type sent with new files
+var fileTypes = map[string]pb.FileType{
+	".txt":        pb.FileType_FILE_TYPE_TXT,
+	".csv":        pb.FileType_FILE_TYPE_CSV,
+	".json":       pb.FileType_FILE_TYPE_JSON,
+	".xml":        pb.FileType_FILE_TYPE_XML,
+	".yaml":       pb.FileType_FILE_TYPE_YAML,
+	".yml":        pb.FileType_FILE_TYPE_YAML,
+	".toml":       pb.FileType_FILE_TYPE_TOML,
+	".ini":        pb.FileType_FILE_TYPE_INI,
+	".properties": pb.FileType_FILE_TYPE_PROPERTIES,
+	".hcl":        pb.FileType_FILE_TYPE_HCL,
+	".tf":         pb.FileType_FILE_TYPE_HCL,
+	".tfvars":     pb.FileType_FILE_TYPE_HCL,
+	".env":        pb.FileType_FILE_TYPE_ENV,
+}
+
+func fileTypeOf(filename string) pb.FileType {
+	if fileType, ok := fileTypes[strings.ToLower(path.Ext(filename))]; ok {
+		return fileType
+	}
+	return pb.FileType_FILE_TYPE_UNSPECIFIED
+}
+
+// parseRevision parses a revision number argument
+func parseRevision(arg string) (int64, error) {
+	revision, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil || revision <= 0 {
+		return 0, fmt.Errorf("invalid revision %q", arg)
+	}
+	return revision, nil
+}
+
+// runGet handles configctl get
+func runGet(a *app, args []string) error {
+	flags := a.flags()
+	owner := flags.String("owner", "", "namespace of the file, a user ID or @team")
+	revision := flags.Int64("revision", 0, "revision to print instead of the latest one")
+	format := flags.String("format", "", "convert structured files to json, yaml, toml, ini, properties or env")
+	if err := parse(flags, args, 1, 1); err != nil {
+		return err
+	}
+	filename := flags.Arg(0)
+	if *revision != 0 && *format != "" {
+		return errors.New("-format cannot be combined with -revision")
+	}
+
+	c, err := a.connect()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := a.callContext()
+	defer cancel()
+
+	var msg proto.Message
+	var data []byte
+	if *revision != 0 {
+		resp, err := c.Service().GetRevision(ctx, &pb.GetRevision{Owner: *owner, Filename: filename, Revision: *revision})
+		if err != nil {
+			return err
+		}
+		msg, data = resp, resp.GetData()
+	} else {
+		resp, err := c.Service().GetConfig(ctx, &pb.GetConfig{Owner: *owner, Filename: filename, Format: *format})
+		if err != nil {
+			return err
+		}
+		msg, data = resp, resp.GetData()
+	}
+
+	// The table format prints the plain content so it can be redirected
+	if a.tableOutput() {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+	return a.printProto(msg, nil)
+}
+
+// runPut handles configctl put
+func runPut(a *app, args []string) error {
+	flags := a.flags()
+	owner := flags.String("owner", "", "namespace of the file, a user ID or @team")
+	from := flags.String("f", "-", "file to upload, - for stdin")
+	revision := flags.Int64("revision", 0, "only update the file if it is at this revision")
+	if err := parse(flags, args, 1, 1); err != nil {
+		return err
+	}
+	filename := flags.Arg(0)
+
+	var data []byte
+	var err error
+	if *from == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(*from)
+	}
+	if err != nil {
+		return err
+	}
+
+	c, err := a.connect()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := a.callContext()
+	defer cancel()
+
+	update := &pb.UpdateConfig{
+		Owner:            *owner,
+		Filename:         filename,
+		FileType:         fileTypeOf(filename),
+		Data:             data,
+		ExpectedRevision: *revision,
+	}
+	if *revision == 0 {
+		_, err := c.Service().AddConfig(ctx, &pb.AddConfig{
+			Owner:    *owner,
+			Filename: filename,
+			FileType: fileTypeOf(filename),
+			Data:     data,
+		})
+		if status.Code(err) != codes.AlreadyExists {
+			if err == nil {
+				fmt.Fprintf(os.Stderr, "created %s\n", filename)
+			}
+			return err
+		}
+	}
+
+	if _, err := c.Service().UpdateConfig(ctx, update); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "updated %s\n", filename)
+	return nil
+}
+
+// runEdit handles configctl edit. The edited file is only written if
+// nobody changed it on the server in the meantime.
+func runEdit(a *app, args []string) error {
+	flags := a.flags()
+	owner := flags.String("owner", "", "namespace of the file, a user ID or @team")
+	if err := parse(flags, args, 1, 1); err != nil {
+		return err
+	}
+	filename := flags.Arg(0)
+
+	c, err := a.connect()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := a.callContext()
+	defer cancel()
+
+	current, err := c.Service().GetConfig(ctx, &pb.GetConfig{Owner: *owner, Filename: filename})
+	if err != nil {
+		return err
+	}
+
+	// Keep the extension so editors pick the right syntax
+	tmp, err := os.CreateTemp("", "configctl-*"+path.Ext(filename))
+	if err != nil {
+		return err
+	}
+	_, err = tmp.Write(current.GetData())
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	edited, err := editFile(tmp.Name())
+	if err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	if bytes.Equal(edited, current.GetData()) {
+		os.Remove(tmp.Name())
+		fmt.Fprintln(os.Stderr, "no changes")
+		return nil
+	}
+
+	ctx, cancel = a.callContext()
+	defer cancel()
+	_, err = c.Service().UpdateConfig(ctx, &pb.UpdateConfig{
+		Owner:            *owner,
+		Filename:         filename,
+		FileType:         current.GetFileType(),
+		Data:             edited,
+		ExpectedRevision: current.GetRevision(),
+	})
+	if err != nil {
+		// Keep the edit so it is not lost
+		return fmt.Errorf("%s; your version is kept in %s", errorMessage(err), tmp.Name())
+	}
+	os.Remove(tmp.Name())
+	fmt.Fprintf(os.Stderr, "updated %s\n", filename)
+	return nil
+}
+
+// editFile opens a file in $VISUAL or $EDITOR and returns its content once
+// the editor exits
+func editFile(name string) ([]byte, error) {
+	editor := os.Getenv("VISUAL")
+	if editor == "" {
+		editor = os.Getenv("EDITOR")
+	}
+	if editor == "" {
+		editor = "vi"
+	}
+
+	// Editors may be configured with arguments, e.g. "code --wait"
+	parts := strings.Fields(editor)
+	cmd := exec.Command(parts[0], append(parts[1:], name)...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("editor failed: %w", err)
+	}
+	return os.ReadFile(name)
+}
+
+// runRm handles configctl rm
+func runRm(a *app, args []string) error {
+	flags := a.flags()
+	owner := flags.String("owner", "", "namespace of the file, a user ID or @team")
+	recursive := flags.Bool("r", false, "delete a directory and every file below it")
+	revision := flags.Int64("revision", 0, "only delete the file if it is at this revision")
+	if err := parse(flags, args, 1, 1); err != nil {
+		return err
+	}
+	name := flags.Arg(0)
+
+	c, err := a.connect()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := a.callContext()
+	defer cancel()
+
+	if *recursive {
+		resp, err := c.Service().DeleteDirectory(ctx, &pb.DeleteDirectory{Owner: *owner, Path: name})
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "deleted %d files\n", resp.GetDeleted())
+		return nil
+	}
+
+	_, err = c.Service().DeleteConfig(ctx, &pb.DeleteConfig{Owner: *owner, Filename: name, ExpectedRevision: *revision})
+	return err
+}
+
+// runLs handles configctl ls
+func runLs(a *app, args []string) error {
+	flags := a.flags()
+	owner := flags.String("owner", "", "namespace to list, a user ID or @team")
+	if err := parse(flags, args, 0, 1); err != nil {
+		return err
+	}
+
+	c, err := a.connect()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := a.callContext()
+	defer cancel()
+
+	list := &pb.ListConfigsResponse{}
+	req := &pb.ListConfigs{Owner: *owner, Prefix: flags.Arg(0)}
+	for {
+		resp, err := c.Service().ListConfigs(ctx, req)
+		if err != nil {
+			return err
+		}
+		list.Configs = append(list.Configs, resp.GetConfigs()...)
+		if resp.GetNextCursor() == "" {
+			break
+		}
+		req.Cursor = resp.GetNextCursor()
+	}
+
+	return a.printProto(list, func(w io.Writer) {
+		fmt.Fprintln(w, "NAME\tSIZE\tREVISION\tUPDATED")
+		for _, config := range list.GetConfigs() {
+			fmt.Fprintf(w, "%s\t%d\t%d\t%s\n", config.GetFilename(), config.GetSize(), config.GetRevision(), formatTime(config.GetUpdatedAt()))
+		}
+	})
+}
+
+// runHistory handles configctl history
+func runHistory(a *app, args []string) error {
+	flags := a.flags()
+	owner := flags.String("owner", "", "namespace of the file, a user ID or @team")
+	if err := parse(flags, args, 1, 1); err != nil {
+		return err
+	}
+
+	c, err := a.connect()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := a.callContext()
+	defer cancel()
+
+	resp, err := c.Service().ListRevisions(ctx, &pb.ListRevisions{Owner: *owner, Filename: flags.Arg(0)})
+	if err != nil {
+		return err
+	}
+
+	return a.printProto(resp, func(w io.Writer) {
+		fmt.Fprintln(w, "REVISION\tAUTHOR\tSIZE\tCREATED\tNOTE")
+		for _, revision := range resp.GetRevisions() {
+			note := ""
+			if revision.GetRollbackOf() != 0 {
+				note = fmt.Sprintf("rollback of %d", revision.GetRollbackOf())
+			}
+			fmt.Fprintf(w, "%d\t%s\t%d\t%s\t%s\n", revision.GetRevision(), revision.GetAuthor(), revision.GetSize(), formatTime(revision.GetCreatedAt()), note)
+		}
+	})
+}
+
+// runDiff handles configctl diff. Without TO the revision is compared with
+// the latest one.
+func runDiff(a *app, args []string) error {
+	flags := a.flags()
+	owner := flags.String("owner", "", "namespace of the file, a user ID or @team")
+	if err := parse(flags, args, 2, 3); err != nil {
+		return err
+	}
+
+	req := &pb.DiffConfig{Owner: *owner, Filename: flags.Arg(0)}
+	var err error
+	if req.FromRevision, err = parseRevision(flags.Arg(1)); err != nil {
+		return err
+	}
+	if flags.NArg() == 3 {
+		if req.ToRevision, err = parseRevision(flags.Arg(2)); err != nil {
+			return err
+		}
+	}
+
+	c, err := a.connect()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := a.callContext()
+	defer cancel()
+
+	resp, err := c.Service().DiffConfig(ctx, req)
+	if err != nil {
+		return err
+	}
+	if a.tableOutput() {
+		_, err = io.WriteString(os.Stdout, resp.GetUnified())
+		return err
+	}
+	return a.printProto(resp, nil)
+}
+
+// runRollback handles configctl rollback
+func runRollback(a *app, args []string) error {
+	flags := a.flags()
+	owner := flags.String("owner", "", "namespace of the file, a user ID or @team")
+	if err := parse(flags, args, 2, 2); err != nil {
+		return err
+	}
+	filename := flags.Arg(0)
+	revision, err := parseRevision(flags.Arg(1))
+	if err != nil {
+		return err
+	}
+
+	c, err := a.connect()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := a.callContext()
+	defer cancel()
+
+	resp, err := c.Service().RollbackConfig(ctx, &pb.RollbackConfig{Owner: *owner, Filename: filename, Revision: revision})
+	if err != nil {
+		return err
+	}
+	return a.printProto(resp, func(w io.Writer) {
+		fmt.Fprintf(w, "restored revision %d of %s as revision %d\n", revision, filename, resp.GetRevision())
+	})
+}
@@ -0,0 +1,190 @@
+// configctl manages configuration files, users and server contexts from the
+// command line through the gRPC API of the config server.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/yash3004/config_server/client"
+	"google.golang.org/grpc/status"
+)
+
+// errUsage is returned when a command was called with invalid arguments
+var errUsage = errors.New("invalid usage")
+
+// command is a subcommand of configctl
+type command struct {
+	usage   string
+	summary string
+	run     func(a *app, args []string) error
+}
+
+var commands = map[string]*command{
+	"context":  {usage: "context set|use|ls|rm ...", summary: "manage server contexts", run: runContext},
+	"login":    {usage: "login [-user ID] [-password-stdin]", summary: "log in and store the token in the current context", run: runLogin},
+	"logout":   {usage: "logout", summary: "revoke the token of the current context", run: runLogout},
+	"get":      {usage: "get [-owner NS] [-revision N | -format F] FILE", summary: "print the content of a file", run: runGet},
+	"put":      {usage: "put [-owner NS] [-f PATH] [-revision N] FILE", summary: "create or update a file from PATH or stdin", run: runPut},
+	"edit":     {usage: "edit [-owner NS] FILE", summary: "edit a file in $EDITOR", run: runEdit},
+	"rm":       {usage: "rm [-owner NS] [-r] [-revision N] PATH", summary: "delete a file or, with -r, a directory", run: runRm},
+	"ls":       {usage: "ls [-owner NS] [PREFIX]", summary: "list files", run: runLs},
+	"history":  {usage: "history [-owner NS] FILE", summary: "list the revisions of a file", run: runHistory},
+	"diff":     {usage: "diff [-owner NS] FILE FROM [TO]", summary: "compare two revisions of a file", run: runDiff},
+	"rollback": {usage: "rollback [-owner NS] FILE REVISION", summary: "restore an earlier revision of a file", run: runRollback},
+	"schema":   {usage: "schema set [-owner NS] [-f PATH] PATTERN | rm [-owner NS] PATTERN | ls [-owner NS]", summary: "manage the JSON Schemas of files", run: runSchema},
+	"users":    {usage: "users add|update|rm ...", summary: "manage users", run: runUsers},
+}
+
+// app holds the global options and the lazily created client
+type app struct {
+	configPath  string
+	contextName string
+	output      string
+	timeout     time.Duration
+
+	// cmd is the command being run
+	cmd    *command
+	config *ctlConfig
+	client *client.Client
+}
+
+func main() {
+	a := &app{}
+	flag.StringVar(&a.configPath, "config", defaultConfigPath(), "configctl configuration file")
+	a.registerFlags(flag.CommandLine)
+	flag.DurationVar(&a.timeout, "timeout", 30*time.Second, "timeout of every call")
+	flag.Usage = usage
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		usage()
+		os.Exit(2)
+	}
+	name := flag.Arg(0)
+	cmd, ok := commands[name]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "configctl: unknown command %q\n", name)
+		usage()
+		os.Exit(2)
+	}
+
+	a.cmd = cmd
+	err := cmd.run(a, flag.Args()[1:])
+	if a.client != nil {
+		a.client.Close()
+	}
+	if err != nil {
+		if errors.Is(err, errUsage) {
+			fmt.Fprintf(os.Stderr, "usage: configctl %s\n", cmd.usage)
+			os.Exit(2)
+		}
+		fmt.Fprintf(os.Stderr, "configctl: %s\n", errorMessage(err))
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: configctl [flags] COMMAND [ARGS]")
+	fmt.Fprintln(os.Stderr, "\nCommands:")
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "  %-10s %s\n", name, commands[name].summary)
+	}
+	fmt.Fprintln(os.Stderr, "\nFlags:")
+	flag.PrintDefaults()
+}
+
+// registerFlags adds the flags accepted before and after the command name
+func (a *app) registerFlags(fs *flag.FlagSet) {
+	fs.StringVar(&a.contextName, "context", a.contextName, "context to use instead of the current one")
+	fs.StringVar(&a.output, "o", a.output, "output format: table, json or yaml")
+}
+
+// flags returns the flag set of the command, which also accepts the global
+// -context and -o flags
+func (a *app) flags() *flag.FlagSet {
+	fs := flag.NewFlagSet(a.cmd.usage, flag.ContinueOnError)
+	a.registerFlags(fs)
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: configctl %s\n", a.cmd.usage)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+// parse parses the flags of a command and checks its number of positional
+// arguments. Unlike flag.Parse it also accepts flags after positional
+// arguments, up to a "--".
+func parse(fs *flag.FlagSet, args []string, minArgs, maxArgs int) error {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				os.Exit(0)
+			}
+			os.Exit(2)
+		}
+		rest := fs.Args()
+		if consumed := len(args) - len(rest); consumed > 0 && args[consumed-1] == "--" {
+			positional = append(positional, rest...)
+			break
+		}
+		if len(rest) == 0 {
+			break
+		}
+		positional = append(positional, rest[0])
+		args = rest[1:]
+	}
+	// Leave the positional arguments in fs.Args
+	if err := fs.Parse(append([]string{"--"}, positional...)); err != nil {
+		return err
+	}
+
+	if fs.NArg() < minArgs || fs.NArg() > maxArgs {
+		return errUsage
+	}
+	return nil
+}
+
+// callContext returns a context bounded by the -timeout flag
+func (a *app) callContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), a.timeout)
+}
+
+// connect returns a client for the server of the selected context
+func (a *app) connect() (*client.Client, error) {
+	if a.client != nil {
+		return a.client, nil
+	}
+
+	ctx, err := a.currentContext()
+	if err != nil {
+		return nil, err
+	}
+	c, err := client.New(ctx.Server, ctx.clientOptions())
+	if err != nil {
+		return nil, err
+	}
+	a.client = c
+	return c, nil
+}
+
+// errorMessage formats the status of failed calls without the rpc error
+// prefix
+func errorMessage(err error) string {
+	if s, ok := status.FromError(err); ok {
+		return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+	}
+	return strings.TrimSpace(err.Error())
+}
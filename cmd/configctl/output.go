@@ -0,0 +1,73 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gopkg.in/yaml.v2"
+)
+
+// printProto prints a response in the selected output format, calling
+// table for the default table format
+func (a *app) printProto(msg proto.Message, table func(w io.Writer)) error {
+	if a.tableOutput() {
+		return printTable(table)
+	}
+
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return a.printValue(value, table)
+}
+
+// printValue prints a value in the selected output format, calling table
+// for the default table format
+func (a *app) printValue(value interface{}, table func(w io.Writer)) error {
+	switch a.output {
+	case "", "table":
+		return printTable(table)
+	case "json":
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(value)
+	case "yaml":
+		data, err := yaml.Marshal(value)
+		if err != nil {
+			return err
+		}
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+	return fmt.Errorf("unknown output format %q", a.output)
+}
+
+// tableOutput reports whether the table output format is selected
+func (a *app) tableOutput() bool {
+	return a.output == "" || a.output == "table"
+}
+
+func printTable(table func(w io.Writer)) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	table(w)
+	return w.Flush()
+}
+
+// formatTime formats a timestamp for tables
+func formatTime(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+	return ts.AsTime().Local().Format(time.DateTime)
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	pb "github.com/yash3004/config_server/generated/protobuf/configpb"
+)
+
+// runSchema handles configctl schema
+func runSchema(a *app, args []string) error {
+	if len(args) == 0 {
+		return errUsage
+	}
+
+	flags := a.flags()
+	owner := flags.String("owner", "", "namespace of the schema, a user ID or @team")
+	var from *string
+	minArgs, maxArgs := 1, 1
+	switch args[0] {
+	case "set":
+		from = flags.String("f", "-", "JSON Schema file, - for stdin")
+	case "rm":
+	case "ls":
+		minArgs, maxArgs = 0, 0
+	default:
+		return errUsage
+	}
+	if err := parse(flags, args[1:], minArgs, maxArgs); err != nil {
+		return err
+	}
+
+	var schema []byte
+	if args[0] == "set" {
+		var err error
+		if *from == "-" {
+			schema, err = io.ReadAll(os.Stdin)
+		} else {
+			schema, err = os.ReadFile(*from)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	c, err := a.connect()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := a.callContext()
+	defer cancel()
+
+	switch args[0] {
+	case "set":
+		_, err = c.Service().SetSchema(ctx, &pb.SetSchema{Owner: *owner, Pattern: flags.Arg(0), Schema: string(schema)})
+		return err
+	case "rm":
+		_, err = c.Service().DeleteSchema(ctx, &pb.DeleteSchema{Owner: *owner, Pattern: flags.Arg(0)})
+		return err
+	}
+
+	resp, err := c.Service().ListSchemas(ctx, &pb.ListSchemas{Owner: *owner})
+	if err != nil {
+		return err
+	}
+	return a.printProto(resp, func(w io.Writer) {
+		fmt.Fprintln(w, "PATTERN\tAUTHOR\tUPDATED")
+		for _, schema := range resp.GetSchemas() {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", schema.GetPattern(), schema.GetAuthor(), formatTime(schema.GetUpdatedAt()))
+		}
+	})
+}
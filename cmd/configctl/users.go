@@ -0,0 +1,188 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	pb "github.com/yash3004/config_server/generated/protobuf/configpb"
+	"golang.org/x/term"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// roles maps role names to their protobuf value
+var roles = map[string]pb.Role{
+	"":       pb.Role_ROLE_UNSPECIFIED,
+	"viewer": pb.Role_ROLE_VIEWER,
+	"editor": pb.Role_ROLE_EDITOR,
+	"admin":  pb.Role_ROLE_ADMIN,
+}
+
+func parseRole(name string) (pb.Role, error) {
+	role, ok := roles[strings.ToLower(name)]
+	if !ok {
+		return 0, fmt.Errorf("invalid role %q, expected viewer, editor or admin", name)
+	}
+	return role, nil
+}
+
+// readPassword reads a password from stdin, prompting for it without echo
+// when stdin is a terminal
+func readPassword(prompt string, fromStdin bool) (string, error) {
+	fd := int(os.Stdin.Fd())
+	if !fromStdin && term.IsTerminal(fd) {
+		fmt.Fprint(os.Stderr, prompt)
+		password, err := term.ReadPassword(fd)
+		fmt.Fprintln(os.Stderr)
+		return string(password), err
+	}
+
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && line == "" {
+		return "", errors.New("no password on stdin")
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+// runLogin handles configctl login
+func runLogin(a *app, args []string) error {
+	flags := a.flags()
+	user := flags.String("user", "", "user to log in as, defaults to the user of the context")
+	passwordStdin := flags.Bool("password-stdin", false, "read the password from stdin")
+	if err := parse(flags, args, 0, 0); err != nil {
+		return err
+	}
+
+	current, err := a.currentContext()
+	if err != nil {
+		return err
+	}
+	if *user == "" {
+		*user = current.User
+	}
+	if *user == "" {
+		return errors.New("no user given, pass -user")
+	}
+	password, err := readPassword("Password: ", *passwordStdin)
+	if err != nil {
+		return err
+	}
+
+	c, err := a.connect()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := a.callContext()
+	defer cancel()
+
+	token, err := c.Login(ctx, *user, password)
+	if err != nil {
+		return err
+	}
+	current.User = *user
+	current.Token = token
+	if err := a.saveConfig(); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "logged in as %s\n", *user)
+	return nil
+}
+
+// runLogout handles configctl logout
+func runLogout(a *app, args []string) error {
+	if err := parse(a.flags(), args, 0, 0); err != nil {
+		return err
+	}
+
+	current, err := a.currentContext()
+	if err != nil {
+		return err
+	}
+	if current.Token == "" {
+		return nil
+	}
+
+	c, err := a.connect()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := a.callContext()
+	defer cancel()
+
+	if _, err := c.Service().Logout(ctx, &emptypb.Empty{}); err != nil {
+		return err
+	}
+	current.Token = ""
+	return a.saveConfig()
+}
+
+// runUsers handles configctl users
+func runUsers(a *app, args []string) error {
+	if len(args) == 0 {
+		return errUsage
+	}
+
+	flags := a.flags()
+	var email, name, role *string
+	var password, passwordStdin *bool
+	switch args[0] {
+	case "add", "update":
+		email = flags.String("email", "", "email address of the user")
+		name = flags.String("name", "", "display name of the user")
+		role = flags.String("role", "", "viewer, editor or admin")
+		password = flags.Bool("password", args[0] == "add", "prompt for a new password")
+		passwordStdin = flags.Bool("password-stdin", false, "read the new password from stdin")
+	case "rm":
+	default:
+		return errUsage
+	}
+	if err := parse(flags, args[1:], 1, 1); err != nil {
+		return err
+	}
+	userID := flags.Arg(0)
+
+	var newPassword string
+	var newRole pb.Role
+	if args[0] != "rm" {
+		var err error
+		if newRole, err = parseRole(*role); err != nil {
+			return err
+		}
+		if *password || *passwordStdin {
+			if newPassword, err = readPassword("New password: ", *passwordStdin); err != nil {
+				return err
+			}
+		}
+	}
+
+	c, err := a.connect()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := a.callContext()
+	defer cancel()
+
+	switch args[0] {
+	case "add":
+		_, err = c.Service().AddUser(ctx, &pb.AddUser{
+			UserId:   userID,
+			Email:    *email,
+			Name:     *name,
+			Password: newPassword,
+			Role:     newRole,
+		})
+	case "update":
+		_, err = c.Service().UpdateUser(ctx, &pb.UpdateUser{
+			UserId:   userID,
+			Email:    *email,
+			Name:     *name,
+			Password: newPassword,
+			Role:     newRole,
+		})
+	case "rm":
+		_, err = c.Service().DeleteUser(ctx, &pb.DeleteUser{UserId: userID})
+	}
+	return err
+}
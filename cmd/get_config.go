@@ -4,6 +4,7 @@ import (
 	"flag"
 	"os"
 	"sync"
+	"time"
 
 	"gopkg.in/yaml.v2"
 	"k8s.io/klog/v2"
@@ -15,9 +16,18 @@ type BindOptions struct {
 }
 
 type Configurations struct {
-	MongoURI string      `yaml:"mongoURI"`
-	Bind     BindOptions `yaml:"bind"`
-	UseFile  bool        `yaml:"use_file"`
+	MongoURI  string      `yaml:"mongoURI"`
+	Bind      BindOptions `yaml:"bind"`
+	UseFile   bool        `yaml:"use_file"`
+	ConfigDir string      `yaml:"config_dir"`
+	// TokenTTL is how long issued bearer tokens stay valid, e.g. "12h"
+	TokenTTL time.Duration `yaml:"token_ttl"`
+	// Admins are existing users given the admin role on startup
+	Admins []string `yaml:"admins"`
+	// LegacyPasswordAuth lets HTTP and gRPC requests without a bearer token
+	// send user_id and password in their body, for clients that still need
+	// to move to tokens
+	LegacyPasswordAuth bool `yaml:"legacy_password_auth"`
 }
 
 var (
@@ -59,4 +69,4 @@ func GetConfigurations() Configurations {
 	})
 
 	return config
-}
\ No newline at end of file
+}
@@ -40,10 +40,31 @@ func main() {
 		log.Fatalf("Failed to connect to MongoDB: %v", err)
 	}
 
-	userManager := users.NewUserManager(db)
-	configManager := configurations.NewConfigManager(db, cfg.UseFile, "configs")
+	userManager := users.NewUserManager(db, cfg.TokenTTL)
+	if err := userManager.PromoteAdmins(ctx, cfg.Admins); err != nil {
+		log.Fatalf("Failed to promote admins: %v", err)
+	}
+
+	var store configurations.Store
+	if cfg.UseFile {
+		configDir := cfg.ConfigDir
+		if configDir == "" {
+			configDir = "configs"
+		}
+		store = configurations.NewFileStore(configDir)
+	} else {
+		store = configurations.NewGridFSStore(db)
+	}
+	configManager := configurations.NewConfigManager(store)
+
+	// Watchers see the changes of other replicas while the store reports them
+	go func() {
+		if err := configManager.FollowStore(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("Change notifications of the store unavailable, watchers only see local changes: %v", err)
+		}
+	}()
 
-	grpcServer := grpc_transport.NewServer(userManager, configManager)
+	grpcServer := grpc_transport.NewServer(userManager, configManager, cfg.LegacyPasswordAuth)
 	go func() {
 		log.Printf("Starting gRPC server on %s", *grpcAddr)
 		if err := grpc_transport.StartGRPCServer(grpcServer, *grpcAddr); err != nil {
@@ -51,7 +72,7 @@ func main() {
 		}
 	}()
 
-	httpServer := http_transport.NewServer(userManager, configManager)
+	httpServer := http_transport.NewServer(userManager, configManager, cfg.LegacyPasswordAuth)
 	go func() {
 		log.Printf("Starting HTTP server on %s", *httpAddr)
 		if err := httpServer.StartHTTPServer(*httpAddr); err != nil {
@@ -0,0 +1,195 @@
+package configurations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// teamNamespacePrefix marks the namespaces owned by teams rather than users
+const teamNamespacePrefix = "@"
+
+var (
+	// ErrAccessDenied is returned when a caller may not access a namespace
+	// or file
+	ErrAccessDenied = errors.New("access denied")
+	// ErrInvalidGrant is returned for grants with an unknown access level or
+	// grantee
+	ErrInvalidGrant = errors.New("invalid grant")
+	// ErrNamespaceNotEmpty is returned when removing the owner of a
+	// namespace that still holds configuration files
+	ErrNamespaceNotEmpty = errors.New("namespace still holds configuration files")
+)
+
+// TeamNamespace returns the namespace holding the configuration files of a
+// team
+func TeamNamespace(team string) string {
+	return teamNamespacePrefix + team
+}
+
+// Caller is the principal on whose behalf configuration files are accessed
+type Caller struct {
+	// UserID is the calling user, whose own namespace has the same name
+	UserID string
+	// Teams are the teams the user belongs to
+	Teams []string
+}
+
+// Namespace returns the namespace addressed by owner, which is the caller's
+// own when owner is empty
+func (c Caller) Namespace(owner string) string {
+	if owner == "" {
+		return c.UserID
+	}
+	return owner
+}
+
+// owns reports whether namespace belongs to the caller or to one of its teams
+func (c Caller) owns(namespace string) bool {
+	if namespace == c.UserID {
+		return true
+	}
+	for _, team := range c.Teams {
+		if namespace == TeamNamespace(team) {
+			return true
+		}
+	}
+	return false
+}
+
+// grantedTo reports whether a grantee names the caller or one of its teams
+func (c Caller) grantedTo(grantee string) bool {
+	return grantee == c.UserID || (strings.HasPrefix(grantee, teamNamespacePrefix) && c.owns(grantee))
+}
+
+// Access is the level of access a grant gives to a configuration file
+type Access string
+
+const (
+	// AccessNone removes a grant
+	AccessNone Access = ""
+	// AccessRead allows reading the file and its history
+	AccessRead Access = "read"
+	// AccessWrite additionally allows updating and rolling back the file
+	AccessWrite Access = "write"
+)
+
+// includes reports whether a grants at least access b
+func (a Access) includes(b Access) bool {
+	return a == AccessWrite || a == b
+}
+
+// Grant gives a user or team access to a configuration file in a namespace
+// it does not own
+type Grant struct {
+	// Grantee is a user ID or a team namespace as returned by TeamNamespace
+	Grantee string `json:"grantee" bson:"grantee"`
+	Access  Access `json:"access" bson:"access"`
+}
+
+// authorizeNamespace validates a namespace, which defaults to the caller's
+// own, and checks that the caller owns it
+func authorizeNamespace(caller Caller, namespace string) (string, error) {
+	namespace = caller.Namespace(namespace)
+	if err := ValidateUserID(namespace); err != nil {
+		return "", err
+	}
+	if !caller.owns(namespace) {
+		return "", ErrAccessDenied
+	}
+	return namespace, nil
+}
+
+// authorizeFile validates the address of a file and checks that the caller
+// owns its namespace or was granted access to the file
+func (cm *ConfigManager) authorizeFile(ctx context.Context, caller Caller, namespace, filename string, access Access) (string, error) {
+	namespace = caller.Namespace(namespace)
+	if err := validateFile(namespace, filename); err != nil {
+		return "", err
+	}
+	if caller.owns(namespace) {
+		return namespace, nil
+	}
+
+	grants, err := cm.store.Grants(ctx, namespace, filename)
+	if err != nil {
+		if errors.Is(err, ErrFileNotFound) {
+			// Do not reveal which files exist in foreign namespaces
+			return "", ErrAccessDenied
+		}
+		return "", err
+	}
+	for _, grant := range grants {
+		if caller.grantedTo(grant.Grantee) && grant.Access.includes(access) {
+			return namespace, nil
+		}
+	}
+	return "", ErrAccessDenied
+}
+
+// CheckNamespaceEmpty returns ErrNamespaceNotEmpty if a namespace holds
+// configuration files. It does not check access and lets the owner of a
+// namespace, such as a team, be removed without leaving files behind for a
+// later owner of the same name.
+func (cm *ConfigManager) CheckNamespaceEmpty(ctx context.Context, namespace string) error {
+	if err := ValidateUserID(namespace); err != nil {
+		return err
+	}
+	files, err := cm.store.List(ctx, namespace, ListOptions{Limit: 1})
+	if err != nil {
+		return err
+	}
+	if len(files) > 0 {
+		return ErrNamespaceNotEmpty
+	}
+	return nil
+}
+
+// RevokeGrants removes the grants to a user or team namespace from every
+// configuration file, so that a later owner of the same name does not
+// inherit them. Like CheckNamespaceEmpty it does not check access.
+func (cm *ConfigManager) RevokeGrants(ctx context.Context, grantee string) error {
+	if err := ValidateUserID(grantee); err != nil {
+		return err
+	}
+	return cm.store.RevokeGrantee(ctx, grantee)
+}
+
+// ListGrants returns the access grants of a configuration file
+func (cm *ConfigManager) ListGrants(ctx context.Context, caller Caller, namespace, filename string) ([]Grant, error) {
+	namespace, err := authorizeNamespace(caller, namespace)
+	if err != nil {
+		return nil, err
+	}
+	if err := validatePath(filename); err != nil {
+		return nil, err
+	}
+	return cm.store.Grants(ctx, namespace, filename)
+}
+
+// SetGrant gives a user or team access to a configuration file, replacing
+// any earlier grant to the same grantee. AccessNone revokes the grant.
+func (cm *ConfigManager) SetGrant(ctx context.Context, caller Caller, namespace, filename string, grant Grant) error {
+	namespace, err := authorizeNamespace(caller, namespace)
+	if err != nil {
+		return err
+	}
+	if err := validatePath(filename); err != nil {
+		return err
+	}
+
+	switch grant.Access {
+	case AccessNone, AccessRead, AccessWrite:
+	default:
+		return fmt.Errorf("%w: unknown access %q", ErrInvalidGrant, grant.Access)
+	}
+	if err := ValidateUserID(grant.Grantee); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidGrant, err)
+	}
+	if grant.Grantee == namespace {
+		return fmt.Errorf("%w: the owner of a namespace always has access", ErrInvalidGrant)
+	}
+
+	return cm.store.SetGrant(ctx, namespace, filename, grant)
+}
@@ -0,0 +1,122 @@
+package configurations
+
+import (
+	"errors"
+	"strings"
+	"sync"
+)
+
+// subscriptionBuffer is the number of events a subscriber may fall behind
+// before its subscription is dropped
+const subscriptionBuffer = 256
+
+// ErrWatchOverflow is returned when a watcher consumed events too slowly and
+// missed some. The watch can be resumed from the last revision it received.
+var ErrWatchOverflow = errors.New("watch fell behind the change feed")
+
+// EventType is the kind of change an Event describes
+type EventType int
+
+const (
+	// EventUpdated is published when a file is created or gets a new
+	// revision, including rollbacks and the destination of moves
+	EventUpdated EventType = iota + 1
+	// EventDeleted is published when a file is deleted or moved away
+	EventDeleted
+	// EventSynced is sent to a watcher once it has received the initial
+	// state of the watched files
+	EventSynced
+)
+
+// Event describes a change of a configuration file
+type Event struct {
+	Type      EventType
+	Namespace string
+	Filename  string
+	// Revision is the new revision of updated files and nil otherwise
+	Revision *Revision
+	// Data is the content of Revision. It is only set on the events
+	// delivered by ConfigManager.Watch.
+	Data []byte
+}
+
+// ChangeBus fans out the changes of configuration files to subscribers
+type ChangeBus struct {
+	mu          sync.Mutex
+	subscribers map[*Subscription]struct{}
+}
+
+// NewChangeBus creates a change bus without subscribers
+func NewChangeBus() *ChangeBus {
+	return &ChangeBus{subscribers: make(map[*Subscription]struct{})}
+}
+
+// Subscription receives the events of a namespace matching a filter
+type Subscription struct {
+	bus       *ChangeBus
+	namespace string
+	opts      WatchOptions
+	events    chan *Event
+}
+
+// Subscribe starts receiving the events of the files in namespace selected
+// by opts. The subscription must be closed once it is no longer used.
+func (b *ChangeBus) Subscribe(namespace string, opts WatchOptions) *Subscription {
+	sub := &Subscription{
+		bus:       b,
+		namespace: namespace,
+		opts:      opts,
+		events:    make(chan *Event, subscriptionBuffer),
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.subscribers[sub] = struct{}{}
+	return sub
+}
+
+// Publish delivers an event to every matching subscriber. Subscribers that
+// fell behind are dropped and find their event channel closed.
+func (b *ChangeBus) Publish(event *Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for sub := range b.subscribers {
+		if !sub.matches(event) {
+			continue
+		}
+		select {
+		case sub.events <- event:
+		default:
+			delete(b.subscribers, sub)
+			close(sub.events)
+		}
+	}
+}
+
+// Events returns the channel delivering the events of the subscription. It
+// is closed when the subscriber fell behind.
+func (s *Subscription) Events() <-chan *Event {
+	return s.events
+}
+
+// Close stops the subscription
+func (s *Subscription) Close() {
+	s.bus.mu.Lock()
+	defer s.bus.mu.Unlock()
+
+	if _, ok := s.bus.subscribers[s]; ok {
+		delete(s.bus.subscribers, s)
+		close(s.events)
+	}
+}
+
+func (s *Subscription) matches(event *Event) bool {
+	if event.Namespace != s.namespace {
+		return false
+	}
+	if s.opts.Filename != "" {
+		return event.Filename == s.opts.Filename
+	}
+	return strings.HasPrefix(event.Filename, s.opts.Prefix)
+}
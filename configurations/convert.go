@@ -0,0 +1,419 @@
+package configurations
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v2"
+)
+
+// Format is a representation structured files can be converted into when
+// they are read. Formats are named like file types.
+type Format string
+
+const (
+	FormatJSON Format = "json"
+	FormatYAML Format = "yaml"
+	FormatTOML Format = "toml"
+	FormatINI  Format = "ini"
+	// FormatProperties flattens a document into Java properties with
+	// dotted keys and [i] indexes, as read by Spring
+	FormatProperties Format = "properties"
+	// FormatEnv flattens a document into KEY=value lines for shell
+	// environments
+	FormatEnv Format = "env"
+)
+
+// ErrUnsupportedConversion is returned for unknown formats and for content
+// that cannot be represented in the requested format
+var ErrUnsupportedConversion = errors.New("unsupported conversion")
+
+// shellSafe matches values that need no quoting in a shell
+var shellSafe = regexp.MustCompile(`^[A-Za-z0-9_@%+=:,./-]+$`)
+
+// ParseFormat converts a format name into a Format. The empty name yields
+// the empty format, which leaves content unchanged.
+func ParseFormat(name string) (Format, error) {
+	if name == "" {
+		return "", nil
+	}
+	fileType, err := ParseFileType(name)
+	if err != nil {
+		return "", fmt.Errorf("%w: unknown format %q", ErrUnsupportedConversion, name)
+	}
+	return Format(fileType.String()), nil
+}
+
+// ConvertConfig converts the content of a file of the given type into
+// format. Structured files can be converted into json, yaml, toml, ini,
+// properties and env; content already in the requested format is returned
+// unchanged. Keys are sorted in the output.
+func ConvertConfig(data []byte, fileType FileType, format Format) ([]byte, error) {
+	if format == "" || string(format) == fileType.String() {
+		return data, nil
+	}
+
+	var encode func(doc interface{}) ([]byte, error)
+	switch format {
+	case FormatJSON:
+		encode = encodeJSON
+	case FormatYAML:
+		encode = encodeYAML
+	case FormatTOML:
+		encode = encodeTOML
+	case FormatINI:
+		encode = encodeINI
+	case FormatProperties:
+		encode = encodeProperties
+	case FormatEnv:
+		encode = encodeEnv
+	}
+	if !fileType.structured() || encode == nil {
+		return nil, fmt.Errorf("%w: %s to %s", ErrUnsupportedConversion, fileType, format)
+	}
+
+	// Content stored before it was validated may not decode
+	doc, err := decodeDocument(fileType, data)
+	if err != nil {
+		if errors.Is(err, ErrUnsupportedConversion) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedConversion, err)
+	}
+	if doc == nil {
+		return nil, nil
+	}
+	return encode(doc)
+}
+
+func encodeJSON(doc interface{}) ([]byte, error) {
+	out, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedConversion, err)
+	}
+	return append(out, '\n'), nil
+}
+
+func encodeYAML(doc interface{}) ([]byte, error) {
+	return yaml.Marshal(plainNumbers(doc))
+}
+
+// plainNumbers replaces the json.Number values of a document with integers
+// or floats, which the YAML and TOML encoders would write as strings
+func plainNumbers(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			v[key] = plainNumbers(item)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = plainNumbers(item)
+		}
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		if u, err := strconv.ParseUint(string(v), 10, 64); err == nil {
+			return u
+		}
+		f, _ := v.Float64()
+		return f
+	}
+	return value
+}
+
+// encodeTOML encodes a document as TOML, which requires a table at the top
+// and has no null
+func encodeTOML(doc interface{}) ([]byte, error) {
+	if _, ok := doc.(map[string]interface{}); !ok {
+		return nil, fmt.Errorf("%w: only objects can be converted to toml", ErrUnsupportedConversion)
+	}
+	if err := checkNulls("", doc); err != nil {
+		return nil, err
+	}
+
+	out, err := toml.Marshal(plainNumbers(doc))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedConversion, err)
+	}
+	return out, nil
+}
+
+// checkNulls fails for the first null value of a document
+func checkNulls(path string, value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		return fmt.Errorf("%w: toml has no null, found at %s", ErrUnsupportedConversion, path)
+	case map[string]interface{}:
+		for _, key := range sortedKeys(v) {
+			if err := checkNulls(path+"/"+key, v[key]); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			if err := checkNulls(path+"/"+strconv.Itoa(i), item); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// encodeEnv flattens a document into sorted KEY=value lines. Variable names
+// join the upper-cased keys and array indexes of a value with "_", so
+// {"db": {"hosts": ["a"]}} becomes DB_HOSTS_0=a. Values are quoted for the
+// shell where needed.
+func encodeEnv(doc interface{}) ([]byte, error) {
+	vars, err := flattenDocument(doc, FormatEnv, joinEnvName)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	for _, name := range sortedNames(vars) {
+		fmt.Fprintf(&buf, "%s=%s\n", name, shellQuote(vars[name]))
+	}
+	return buf.Bytes(), nil
+}
+
+// encodeProperties flattens a document into sorted properties, so
+// {"db": {"hosts": ["a"]}} becomes db.hosts[0]=a
+func encodeProperties(doc interface{}) ([]byte, error) {
+	props, err := flattenDocument(doc, FormatProperties, joinPropertyName)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	for _, key := range sortedNames(props) {
+		fmt.Fprintf(&buf, "%s=%s\n", escapeProperty(key, true), escapeProperty(props[key], false))
+	}
+	return buf.Bytes(), nil
+}
+
+// encodeINI writes the scalars and arrays at the top of a document before
+// the first section and every object at the top as a section. Values
+// nested further are flattened like properties.
+func encodeINI(doc interface{}) ([]byte, error) {
+	root, ok := doc.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%w: only objects can be converted to ini", ErrUnsupportedConversion)
+	}
+
+	global := make(map[string]interface{})
+	var sections []string
+	for key, value := range root {
+		if _, ok := value.(map[string]interface{}); ok {
+			sections = append(sections, key)
+		} else {
+			global[key] = value
+		}
+	}
+	sort.Strings(sections)
+
+	var buf bytes.Buffer
+	if err := writeINIKeys(&buf, global); err != nil {
+		return nil, err
+	}
+	for _, section := range sections {
+		if section == "" || strings.ContainsAny(section, "[]\r\n") || strings.TrimSpace(section) != section {
+			return nil, fmt.Errorf("%w: section %q cannot be written to ini", ErrUnsupportedConversion, section)
+		}
+		if buf.Len() > 0 {
+			buf.WriteByte('\n')
+		}
+		fmt.Fprintf(&buf, "[%s]\n", section)
+		if err := writeINIKeys(&buf, root[section]); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// writeINIKeys writes the flattened keys of an object as key = value
+// lines, quoting values that would otherwise lose whitespace or quotes
+func writeINIKeys(buf *bytes.Buffer, values interface{}) error {
+	keys, err := flattenDocument(values, FormatINI, joinPropertyName)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range sortedNames(keys) {
+		value := keys[key]
+		if key == "" || strings.ContainsAny(key, "=:\r\n") || strings.ContainsAny(key[:1], "[;#") || strings.TrimSpace(key) != key {
+			return fmt.Errorf("%w: key %q cannot be written to ini", ErrUnsupportedConversion, key)
+		}
+		if strings.ContainsAny(value, "\r\n") {
+			return fmt.Errorf("%w: the value of %s has line breaks, which ini does not support", ErrUnsupportedConversion, key)
+		}
+		if strings.TrimSpace(value) != value || unquote(value) != value {
+			value = `"` + value + `"`
+		}
+		fmt.Fprintf(buf, "%s = %s\n", key, value)
+	}
+	return nil
+}
+
+// flattenDocument maps the scalars of an object to names built by join
+// from their key paths
+func flattenDocument(doc interface{}, format Format, join func(name, key string, index bool) string) (map[string]string, error) {
+	if _, ok := doc.(map[string]interface{}); !ok {
+		return nil, fmt.Errorf("%w: only objects can be converted to %s", ErrUnsupportedConversion, format)
+	}
+
+	values := make(map[string]string)
+	paths := make(map[string]string)
+	if err := flatten("", "", doc, join, values, paths); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// flatten adds the scalar values below value to values under name. paths
+// records the key path of every name to detect keys that map to the same
+// name.
+func flatten(name, path string, value interface{}, join func(name, key string, index bool) string, values, paths map[string]string) error {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for _, key := range sortedKeys(v) {
+			if err := flatten(join(name, key, false), path+"/"+key, v[key], join, values, paths); err != nil {
+				return err
+			}
+		}
+		return nil
+	case []interface{}:
+		for i, item := range v {
+			index := strconv.Itoa(i)
+			if err := flatten(join(name, index, true), path+"/"+index, item, join, values, paths); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if existing, ok := paths[name]; ok {
+		return fmt.Errorf("%w: %s and %s both map to %s", ErrUnsupportedConversion, existing, path, name)
+	}
+	paths[name] = path
+	values[name] = scalarString(value)
+	return nil
+}
+
+// joinEnvName appends a key to a variable name, replacing the characters
+// not allowed in variable names
+func joinEnvName(name, key string, _ bool) string {
+	part := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z':
+			return r - 'a' + 'A'
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_':
+			return r
+		}
+		return '_'
+	}, key)
+
+	if name == "" {
+		if part == "" || (part[0] >= '0' && part[0] <= '9') {
+			return "_" + part
+		}
+		return part
+	}
+	return name + "_" + part
+}
+
+// joinPropertyName appends a key to a property name with a dot, or an
+// array index in brackets
+func joinPropertyName(name, key string, index bool) string {
+	switch {
+	case index:
+		return name + "[" + key + "]"
+	case name == "":
+		return key
+	}
+	return name + "." + key
+}
+
+// scalarString formats a scalar value; null becomes the empty string and
+// JSON numbers are written as they were stored
+func scalarString(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	}
+	return fmt.Sprint(value)
+}
+
+// shellQuote quotes a value for POSIX shells unless it is safe as is
+func shellQuote(value string) string {
+	if value == "" || shellSafe.MatchString(value) {
+		return value
+	}
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
+
+// escapeProperty escapes a properties key or value. Characters outside of
+// printable ASCII are written as \uxxxx, since properties files are read
+// as ISO 8859-1.
+func escapeProperty(s string, key bool) string {
+	var b strings.Builder
+	for i, r := range s {
+		switch {
+		case r == '\\':
+			b.WriteString(`\\`)
+		case r == '\t':
+			b.WriteString(`\t`)
+		case r == '\n':
+			b.WriteString(`\n`)
+		case r == '\r':
+			b.WriteString(`\r`)
+		case r == '\f':
+			b.WriteString(`\f`)
+		case r == ' ' && (key || i == 0):
+			b.WriteString(`\ `)
+		case strings.ContainsRune("=:#!", r) && (key || i == 0):
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r < 0x20 || r > 0x7e:
+			for _, unit := range utf16.Encode([]rune{r}) {
+				fmt.Fprintf(&b, `\u%04x`, unit)
+			}
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedNames(m map[string]string) []string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,303 @@
+package configurations
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestConvertConfig(t *testing.T) {
+	const source = `{"name": "app", "port": 8080, "ratio": 0.5, "debug": false, "tags": ["a b", "c"], "db": {"host": "localhost", "users": [{"name": "x"}], "password": null}}`
+	tests := []struct {
+		name     string
+		fileType FileType
+		data     string
+		format   Format
+		want     string
+	}{
+		{
+			name:     "json to env",
+			fileType: FileTypeJSON,
+			data:     source,
+			format:   FormatEnv,
+			want: "DB_HOST=localhost\nDB_PASSWORD=\nDB_USERS_0_NAME=x\nDEBUG=false\nNAME=app\nPORT=8080\nRATIO=0.5\n" +
+				"TAGS_0='a b'\nTAGS_1=c\n",
+		},
+		{
+			name:     "json to properties",
+			fileType: FileTypeJSON,
+			data:     source,
+			format:   FormatProperties,
+			want: "db.host=localhost\ndb.password=\ndb.users[0].name=x\ndebug=false\nname=app\nport=8080\nratio=0.5\n" +
+				"tags[0]=a b\ntags[1]=c\n",
+		},
+		{
+			name:     "json to ini",
+			fileType: FileTypeJSON,
+			data:     source,
+			format:   FormatINI,
+			want: "debug = false\nname = app\nport = 8080\nratio = 0.5\ntags[0] = a b\ntags[1] = c\n" +
+				"\n[db]\nhost = localhost\npassword = \nusers[0].name = x\n",
+		},
+		{
+			name:     "json to yaml",
+			fileType: FileTypeJSON,
+			data:     `{"b": [1, 2.5], "a": {"c": "x"}}`,
+			format:   FormatYAML,
+			want:     "a:\n  c: x\nb:\n- 1\n- 2.5\n",
+		},
+		{
+			name:     "json to toml",
+			fileType: FileTypeJSON,
+			data:     `{"b": [1, 2], "a": {"c": "x"}, "d": 1.5}`,
+			format:   FormatTOML,
+			want:     "b = [1, 2]\nd = 1.5\n\n[a]\nc = 'x'\n",
+		},
+		{
+			name:     "large json numbers stay exact",
+			fileType: FileTypeJSON,
+			data:     `{"id": 9007199254740993, "max": 18446744073709551615}`,
+			format:   FormatYAML,
+			want:     "id: 9007199254740993\nmax: 18446744073709551615\n",
+		},
+		{
+			name:     "yaml to json",
+			fileType: FileTypeYAML,
+			data:     "b: [1, 2]\na:\n  c: x\n",
+			format:   FormatJSON,
+			want:     "{\n  \"a\": {\n    \"c\": \"x\"\n  },\n  \"b\": [\n    1,\n    2\n  ]\n}\n",
+		},
+		{
+			name:     "yaml keys that are not strings",
+			fileType: FileTypeYAML,
+			data:     "ports:\n  443: https\n  80: http\n",
+			format:   FormatEnv,
+			want:     "PORTS_443=https\nPORTS_80=http\n",
+		},
+		{
+			name:     "toml dates to json",
+			fileType: FileTypeTOML,
+			data:     "day = 1979-05-27\n",
+			format:   FormatJSON,
+			want:     "{\n  \"day\": \"1979-05-27\"\n}\n",
+		},
+		{
+			name:     "env names are upper-cased and sanitized",
+			fileType: FileTypeJSON,
+			data:     `{"my-app": {"log.level": "debug"}, "1st": "x", "": "empty"}`,
+			format:   FormatEnv,
+			want:     "MY_APP_LOG_LEVEL=debug\n_=empty\n_1ST=x\n",
+		},
+		{
+			name:     "env values are quoted for the shell",
+			fileType: FileTypeJSON,
+			data:     `{"a": "it's", "b": "$HOME", "c": "", "d": "x=y,z/w"}`,
+			format:   FormatEnv,
+			want:     "A='it'\\''s'\nB='$HOME'\nC=\nD=x=y,z/w\n",
+		},
+		{
+			name:     "properties escapes",
+			fileType: FileTypeJSON,
+			data:     `{"a b": " x=y", "c:d": "tab\there", "e": "café 😀", "#f": "#g"}`,
+			format:   FormatProperties,
+			want:     "\\#f=\\#g\na\\ b=\\ x=y\nc\\:d=tab\\there\ne=caf\\u00e9 \\ud83d\\ude00\n",
+		},
+		{
+			name:     "ini quotes values with outer whitespace or quotes",
+			fileType: FileTypeJSON,
+			data:     `{"s": {"a": " x ", "b": "\"q\"", "c": "it's"}}`,
+			format:   FormatINI,
+			want:     "[s]\na = \" x \"\nb = \"\"q\"\"\nc = it's\n",
+		},
+		{
+			name:     "same format is unchanged",
+			fileType: FileTypeYAML,
+			data:     "b: 1\na: 2\n",
+			format:   FormatYAML,
+			want:     "b: 1\na: 2\n",
+		},
+		{
+			name:     "no format is unchanged",
+			fileType: FileTypeCSV,
+			data:     "a,b\n",
+			format:   "",
+			want:     "a,b\n",
+		},
+		{
+			name:     "empty content",
+			fileType: FileTypeJSON,
+			data:     "\n",
+			format:   FormatYAML,
+			want:     "",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ConvertConfig([]byte(test.data), test.fileType, test.format)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(got) != test.want {
+				t.Errorf("got\n%s\nwant\n%s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestConvertConfigRoundTrip(t *testing.T) {
+	const source = `{"name": "app", "port": 8080, "ratio": 0.5, "debug": true, "tags": ["a", "b"], "db": {"hosts": [{"name": "x", "port": 1}], "empty": {}}}`
+	doc, err := decodeDocument(FileTypeJSON, []byte(source))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := jsonValues(doc, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		via      Format
+		viaType  FileType
+		back     Format
+		backType FileType
+	}{
+		{FormatYAML, FileTypeYAML, FormatJSON, FileTypeJSON},
+		{FormatTOML, FileTypeTOML, FormatJSON, FileTypeJSON},
+		{FormatYAML, FileTypeYAML, FormatTOML, FileTypeTOML},
+		{FormatTOML, FileTypeTOML, FormatYAML, FileTypeYAML},
+	}
+	for _, test := range tests {
+		t.Run(string(test.via)+" to "+string(test.back), func(t *testing.T) {
+			converted, err := ConvertConfig([]byte(source), FileTypeJSON, test.via)
+			if err != nil {
+				t.Fatalf("converting to %s: %v", test.via, err)
+			}
+			back, err := ConvertConfig(converted, test.viaType, test.back)
+			if err != nil {
+				t.Fatalf("converting to %s: %v", test.back, err)
+			}
+			decoded, err := decodeDocument(test.backType, back)
+			if err != nil {
+				t.Fatalf("decoding %s: %v", test.back, err)
+			}
+			got, err := jsonValues(decoded, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("got %#v, want %#v", got, want)
+			}
+		})
+	}
+}
+
+func TestConvertConfigFlatRoundTrip(t *testing.T) {
+	const source = `{"a b": " lead", "c": "it's $x", "d": "x\ny", "e": "café 😀", "f": "#!=:\\", "g": ["", "1"]}`
+	tests := []struct {
+		format   Format
+		fileType FileType
+		want     map[string]interface{}
+	}{
+		{
+			format:   FormatProperties,
+			fileType: FileTypeProperties,
+			want: map[string]interface{}{
+				"a b": " lead", "c": "it's $x", "d": "x\ny", "e": "café 😀", "f": "#!=:\\", "g[0]": "", "g[1]": "1",
+			},
+		},
+		{
+			format:   FormatEnv,
+			fileType: FileTypeEnv,
+			want: map[string]interface{}{
+				"A_B": " lead", "C": "it's $x", "D": "x\ny", "E": "café 😀", "F": "#!=:\\", "G_0": "", "G_1": "1",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(string(test.format), func(t *testing.T) {
+			converted, err := ConvertConfig([]byte(source), FileTypeJSON, test.format)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got, err := decodeDocument(test.fileType, converted)
+			if err != nil {
+				t.Fatalf("decoding %q: %v", converted, err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %#v, want %#v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestConvertConfigErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		fileType FileType
+		data     string
+		format   Format
+		message  string
+	}{
+		{name: "csv source", fileType: FileTypeCSV, data: "a,b\n1,2\n", format: FormatJSON},
+		{name: "text source", fileType: FileTypeTXT, data: "hello", format: FormatYAML},
+		{name: "xml source", fileType: FileTypeXML, data: "<a/>", format: FormatJSON},
+		{name: "hcl format", fileType: FileTypeJSON, data: `{"a": 1}`, format: Format("hcl")},
+		{name: "unknown format", fileType: FileTypeJSON, data: `{"a": 1}`, format: Format("docx")},
+		{name: "array to env", fileType: FileTypeJSON, data: `[1, 2]`, format: FormatEnv},
+		{name: "scalar to env", fileType: FileTypeYAML, data: "hello", format: FormatEnv},
+		{name: "scalar to properties", fileType: FileTypeYAML, data: "hello", format: FormatProperties},
+		{name: "array to toml", fileType: FileTypeJSON, data: `[1, 2]`, format: FormatTOML},
+		{name: "array to ini", fileType: FileTypeJSON, data: `[{"a": 1}]`, format: FormatINI},
+		{name: "number too large for toml", fileType: FileTypeJSON, data: `{"a": 18446744073709551615}`, format: FormatTOML},
+		{name: "null in toml", fileType: FileTypeJSON, data: `{"a": {"b": [1, null]}}`, format: FormatTOML, message: "/a/b/1"},
+		{name: "env names collide", fileType: FileTypeJSON, data: `{"a_b": 1, "a": {"b": 2}}`, format: FormatEnv, message: "both map to"},
+		{name: "env names collide by case", fileType: FileTypeJSON, data: `{"a": 1, "A": 2}`, format: FormatEnv, message: "both map to"},
+		{name: "env names collide after sanitizing", fileType: FileTypeJSON, data: `{"a-b": 1, "a.b": 2}`, format: FormatEnv, message: "both map to A_B"},
+		{name: "property names collide", fileType: FileTypeJSON, data: `{"a.b": 1, "a": {"b": 2}}`, format: FormatProperties, message: "both map to"},
+		{name: "property indexes collide", fileType: FileTypeJSON, data: `{"a[0]": 1, "a": [2]}`, format: FormatProperties, message: "both map to"},
+		{name: "ini names collide", fileType: FileTypeJSON, data: `{"s": {"a.b": 1, "a": {"b": 2}}}`, format: FormatINI, message: "both map to"},
+		{name: "ini value with a line break", fileType: FileTypeJSON, data: `{"a": "x\ny"}`, format: FormatINI},
+		{name: "ini key with a separator", fileType: FileTypeJSON, data: `{"a=b": 1}`, format: FormatINI},
+		{name: "ini section with a bracket", fileType: FileTypeJSON, data: `{"a]": {"b": 1}}`, format: FormatINI},
+		{name: "invalid source", fileType: FileTypeJSON, data: `{"a": `, format: FormatYAML},
+		{name: "hcl variables", fileType: FileTypeHCL, data: "a = var.b\n", format: FormatJSON},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ConvertConfig([]byte(test.data), test.fileType, test.format)
+			if !errors.Is(err, ErrUnsupportedConversion) {
+				t.Fatalf("error %v does not wrap ErrUnsupportedConversion", err)
+			}
+			if !strings.Contains(err.Error(), test.message) {
+				t.Errorf("error %q does not contain %q", err, test.message)
+			}
+		})
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    Format
+		wantErr bool
+	}{
+		{"", "", false},
+		{"json", FormatJSON, false},
+		{"YML", FormatYAML, false},
+		{"toml", FormatTOML, false},
+		{"properties", FormatProperties, false},
+		{"INI", FormatINI, false},
+		{"env", FormatEnv, false},
+		{"docx", "", true},
+	}
+	for _, test := range tests {
+		got, err := ParseFormat(test.name)
+		if got != test.want || (err != nil) != test.wantErr {
+			t.Errorf("ParseFormat(%q) = %q, %v; want %q, error %v", test.name, got, err, test.want, test.wantErr)
+		}
+		if err != nil && !errors.Is(err, ErrUnsupportedConversion) {
+			t.Errorf("ParseFormat(%q) error %v does not wrap ErrUnsupportedConversion", test.name, err)
+		}
+	}
+}
@@ -14,7 +14,7 @@ func InitMongoDB(ctx context.Context, uri string) (*mongo.Database, error) {
 	if err != nil {
 		return nil, err
 	}
-	
+
 	err = client.Ping(ctx, nil)
 	if err != nil {
 		return nil, err
@@ -31,7 +31,23 @@ type ConfigFile struct {
 	ID        string    `bson:"_id"`
 	UserID    string    `bson:"user_id"`
 	Filename  string    `bson:"filename"`
-	FileType  int       `bson:"file_type"`
+	FileType  FileType  `bson:"file_type"`
+	Size      int64     `bson:"size"`
+	Revision  int       `bson:"revision"`
+	Hash      string    `bson:"hash"`
+	UpdatedBy string    `bson:"updated_by"`
 	CreatedAt time.Time `bson:"created_at"`
 	UpdatedAt time.Time `bson:"updated_at"`
-}
\ No newline at end of file
+}
+
+// Revision is an immutable version of a configuration file
+type Revision struct {
+	Number    int       `bson:"revision" json:"revision"`
+	Author    string    `bson:"author" json:"author"`
+	Hash      string    `bson:"hash" json:"hash"`
+	Size      int64     `bson:"size" json:"size"`
+	FileType  FileType  `bson:"file_type" json:"file_type"`
+	CreatedAt time.Time `bson:"created_at" json:"created_at"`
+	// RollbackOf is the revision whose content this revision restored
+	RollbackOf int `bson:"rollback_of,omitempty" json:"rollback_of,omitempty"`
+}
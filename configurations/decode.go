@@ -0,0 +1,226 @@
+package configurations
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/pelletier/go-toml/v2"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+	"gopkg.in/yaml.v2"
+)
+
+// decodeDocument decodes the content of a structured file into generic
+// values: maps with string keys, slices and scalars, with JSON numbers kept
+// as json.Number. The values of INI, properties and .env files are strings.
+// Empty content yields a nil document.
+func decodeDocument(fileType FileType, data []byte) (interface{}, error) {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, nil
+	}
+
+	var doc interface{}
+	var err *ValidationError
+	switch fileType {
+	case FileTypeJSON:
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.UseNumber()
+		if err := decoder.Decode(&doc); err != nil {
+			return nil, err
+		}
+		return doc, nil
+	case FileTypeYAML:
+		if err := yaml.Unmarshal(data, &doc); err != nil {
+			return nil, err
+		}
+		return normalizeYAML(doc), nil
+	case FileTypeTOML:
+		doc, err = decodeTOML(data)
+	case FileTypeHCL:
+		return decodeHCL(data)
+	case FileTypeINI:
+		doc, err = decodeINI(data)
+	case FileTypeProperties:
+		doc, err = decodeProperties(data)
+	case FileTypeEnv:
+		doc, err = decodeEnv(data)
+	default:
+		return nil, fmt.Errorf("%w: %s files have no keys", ErrUnsupportedConversion, fileType)
+	}
+	if err != nil {
+		err.FileType = fileType
+		return nil, err
+	}
+	return doc, nil
+}
+
+// DecodeDocument decodes the content of a structured file like the server
+// reads it, into the generic values described at decodeDocument
+func DecodeDocument(fileType FileType, data []byte) (interface{}, error) {
+	return decodeDocument(fileType, data)
+}
+
+// jsonValues re-encodes a decoded document as JSON, so that documents of
+// different types hold the same Go types for the same values
+func jsonValues(doc interface{}, useNumber bool) (interface{}, error) {
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	if useNumber {
+		decoder.UseNumber()
+	}
+	var values interface{}
+	if err := decoder.Decode(&values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// decodeTOML decodes a TOML document. Dates and times become strings in
+// their TOML notation.
+func decodeTOML(data []byte) (map[string]interface{}, *ValidationError) {
+	var doc map[string]interface{}
+	err := toml.Unmarshal(data, &doc)
+	if err == nil {
+		return normalizeTOML(doc).(map[string]interface{}), nil
+	}
+
+	var decodeErr *toml.DecodeError
+	if errors.As(err, &decodeErr) {
+		line, column := decodeErr.Position()
+		return nil, &ValidationError{Line: line, Column: column, Message: decodeErr.Error()}
+	}
+	return nil, &ValidationError{Message: err.Error()}
+}
+
+// normalizeTOML replaces the date and time values of a TOML document with
+// strings
+func normalizeTOML(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			v[key] = normalizeTOML(item)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = normalizeTOML(item)
+		}
+	case time.Time:
+		return v.Format(time.RFC3339Nano)
+	case toml.LocalDate:
+		return v.String()
+	case toml.LocalTime:
+		return v.String()
+	case toml.LocalDateTime:
+		return v.String()
+	}
+	return value
+}
+
+// parseHCL parses HCL native syntax without evaluating it, so files
+// referencing variables and functions are valid
+func parseHCL(data []byte) (*hclsyntax.Body, *ValidationError) {
+	file, diags := hclsyntax.ParseConfig(data, "", hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, hclError(diags)
+	}
+	return file.Body.(*hclsyntax.Body), nil
+}
+
+// hclError converts the first error of HCL diagnostics
+func hclError(diags hcl.Diagnostics) *ValidationError {
+	for _, diag := range diags {
+		if diag.Severity != hcl.DiagError {
+			continue
+		}
+		err := &ValidationError{Message: diag.Summary}
+		if diag.Detail != "" {
+			err.Message += ": " + diag.Detail
+		}
+		if diag.Subject != nil {
+			err.Line, err.Column = diag.Subject.Start.Line, diag.Subject.Start.Column
+		}
+		return err
+	}
+	return &ValidationError{Message: diags.Error()}
+}
+
+// decodeHCL decodes an HCL file whose attributes are constant. Blocks
+// become objects nested by their type and labels, like in the JSON syntax
+// of HCL; repeated blocks become arrays.
+func decodeHCL(data []byte) (interface{}, error) {
+	body, err := parseHCL(data)
+	if err != nil {
+		err.FileType = FileTypeHCL
+		return nil, err
+	}
+	return hclBody(body)
+}
+
+func hclBody(body *hclsyntax.Body) (map[string]interface{}, error) {
+	doc := make(map[string]interface{}, len(body.Attributes))
+	for name, attr := range body.Attributes {
+		value, diags := attr.Expr.Value(nil)
+		if diags.HasErrors() {
+			err := hclError(diags)
+			return nil, fmt.Errorf("%w: %s at line %d is not a constant: %s", ErrUnsupportedConversion, name, err.Line, err.Message)
+		}
+		if !value.IsWhollyKnown() {
+			return nil, fmt.Errorf("%w: %s is not a constant", ErrUnsupportedConversion, name)
+		}
+		encoded, err := ctyjson.Marshal(value, value.Type())
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrUnsupportedConversion, name, err)
+		}
+		decoder := json.NewDecoder(bytes.NewReader(encoded))
+		decoder.UseNumber()
+		var decoded interface{}
+		if err := decoder.Decode(&decoded); err != nil {
+			return nil, err
+		}
+		doc[name] = decoded
+	}
+
+	for _, block := range body.Blocks {
+		content, err := hclBody(block.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		keys := append([]string{block.Type}, block.Labels...)
+		parent := doc
+		for _, key := range keys[:len(keys)-1] {
+			child, ok := parent[key].(map[string]interface{})
+			if !ok {
+				if _, exists := parent[key]; exists {
+					return nil, fmt.Errorf("%w: block %s at line %d conflicts with %s", ErrUnsupportedConversion, block.Type, block.TypeRange.Start.Line, key)
+				}
+				child = make(map[string]interface{})
+				parent[key] = child
+			}
+			parent = child
+		}
+
+		last := keys[len(keys)-1]
+		switch existing := parent[last].(type) {
+		case nil:
+			if _, exists := parent[last]; exists {
+				return nil, fmt.Errorf("%w: block %s at line %d conflicts with %s", ErrUnsupportedConversion, block.Type, block.TypeRange.Start.Line, last)
+			}
+			parent[last] = content
+		case []interface{}:
+			parent[last] = append(existing, content)
+		case map[string]interface{}:
+			parent[last] = []interface{}{existing, content}
+		default:
+			return nil, fmt.Errorf("%w: block %s at line %d conflicts with %s", ErrUnsupportedConversion, block.Type, block.TypeRange.Start.Line, last)
+		}
+	}
+	return doc, nil
+}
@@ -0,0 +1,151 @@
+package configurations
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestDecodeDocument(t *testing.T) {
+	tests := []struct {
+		name     string
+		fileType FileType
+		data     string
+		want     interface{}
+	}{
+		{
+			name:     "empty content",
+			fileType: FileTypeJSON,
+			data:     " \n",
+			want:     nil,
+		},
+		{
+			name:     "JSON numbers",
+			fileType: FileTypeJSON,
+			data:     `{"a": 1.50, "b": [true, null]}`,
+			want:     map[string]interface{}{"a": json.Number("1.50"), "b": []interface{}{true, nil}},
+		},
+		{
+			name:     "YAML keys become strings",
+			fileType: FileTypeYAML,
+			data:     "a:\n  1: x\n  true: z\n",
+			want:     map[string]interface{}{"a": map[string]interface{}{"1": "x", "true": "z"}},
+		},
+		{
+			name:     "TOML dates become strings",
+			fileType: FileTypeTOML,
+			data:     "at = 1979-05-27T07:32:00Z\nday = 1979-05-27\ntime = 07:32:00\nlocal = 1979-05-27T07:32:00\n",
+			want: map[string]interface{}{
+				"at":    "1979-05-27T07:32:00Z",
+				"day":   "1979-05-27",
+				"time":  "07:32:00",
+				"local": "1979-05-27T07:32:00",
+			},
+		},
+		{
+			name:     "TOML tables and arrays",
+			fileType: FileTypeTOML,
+			data:     "[server]\nports = [80, 443]\n[[users]]\nname = \"a\"\n",
+			want: map[string]interface{}{
+				"server": map[string]interface{}{"ports": []interface{}{int64(80), int64(443)}},
+				"users":  []interface{}{map[string]interface{}{"name": "a"}},
+			},
+		},
+		{
+			name:     "HCL attributes",
+			fileType: FileTypeHCL,
+			data:     "name = \"app\"\nsize = 2\ntags = [\"a\", \"b\"]\nlimits = { cpu = 1 }\n",
+			want: map[string]interface{}{
+				"name":   "app",
+				"size":   json.Number("2"),
+				"tags":   []interface{}{"a", "b"},
+				"limits": map[string]interface{}{"cpu": json.Number("1")},
+			},
+		},
+		{
+			name:     "HCL blocks nest by type and labels",
+			fileType: FileTypeHCL,
+			data:     "resource \"bucket\" \"logs\" {\n  size = 1\n}\nresource \"bucket\" \"data\" {\n  size = 2\n}\nsettings {\n  debug = true\n}\n",
+			want: map[string]interface{}{
+				"resource": map[string]interface{}{
+					"bucket": map[string]interface{}{
+						"logs": map[string]interface{}{"size": json.Number("1")},
+						"data": map[string]interface{}{"size": json.Number("2")},
+					},
+				},
+				"settings": map[string]interface{}{"debug": true},
+			},
+		},
+		{
+			name:     "repeated HCL blocks become arrays",
+			fileType: FileTypeHCL,
+			data:     "rule {\n  port = 80\n}\nrule {\n  port = 443\n}\nrule {\n  port = 8080\n}\n",
+			want: map[string]interface{}{
+				"rule": []interface{}{
+					map[string]interface{}{"port": json.Number("80")},
+					map[string]interface{}{"port": json.Number("443")},
+					map[string]interface{}{"port": json.Number("8080")},
+				},
+			},
+		},
+		{
+			name:     "INI",
+			fileType: FileTypeINI,
+			data:     "[s]\na = 1\n",
+			want:     map[string]interface{}{"s": map[string]interface{}{"a": "1"}},
+		},
+		{
+			name:     "properties",
+			fileType: FileTypeProperties,
+			data:     "a.b = 1\n",
+			want:     map[string]interface{}{"a.b": "1"},
+		},
+		{
+			name:     ".env",
+			fileType: FileTypeEnv,
+			data:     "A=1\n",
+			want:     map[string]interface{}{"A": "1"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := decodeDocument(test.fileType, []byte(test.data))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %#v, want %#v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestDecodeDocumentErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		fileType    FileType
+		data        string
+		unsupported bool
+	}{
+		{name: "HCL variables", fileType: FileTypeHCL, data: "size = var.size\n", unsupported: true},
+		{name: "HCL functions", fileType: FileTypeHCL, data: "name = upper(\"a\")\n", unsupported: true},
+		{name: "HCL block named like an attribute", fileType: FileTypeHCL, data: "rule = 1\nrule {\n}\n", unsupported: true},
+		{name: "HCL syntax", fileType: FileTypeHCL, data: "a = \n"},
+		{name: "TOML syntax", fileType: FileTypeTOML, data: "a = \n"},
+		{name: "text", fileType: FileTypeTXT, data: "hello", unsupported: true},
+		{name: "CSV", fileType: FileTypeCSV, data: "a,b\n", unsupported: true},
+		{name: "XML", fileType: FileTypeXML, data: "<a/>", unsupported: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := decodeDocument(test.fileType, []byte(test.data))
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if got := errors.Is(err, ErrUnsupportedConversion); got != test.unsupported {
+				t.Errorf("errors.Is(%v, ErrUnsupportedConversion) = %v, want %v", err, got, test.unsupported)
+			}
+		})
+	}
+}
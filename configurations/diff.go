@@ -0,0 +1,214 @@
+package configurations
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// ChangeKind describes how a key changed between two revisions
+type ChangeKind string
+
+const (
+	ChangeAdded    ChangeKind = "added"
+	ChangeRemoved  ChangeKind = "removed"
+	ChangeModified ChangeKind = "modified"
+)
+
+// KeyChange is a change of a single key path between two revisions.
+// Values are JSON encoded; OldValue is empty for added keys and NewValue is
+// empty for removed keys.
+type KeyChange struct {
+	Path     string     `json:"path"`
+	Kind     ChangeKind `json:"change"`
+	OldValue string     `json:"old_value,omitempty"`
+	NewValue string     `json:"new_value,omitempty"`
+}
+
+// Diff is the difference between two revisions of a configuration file
+type Diff struct {
+	From    int
+	To      int
+	Unified string
+	// Structural is set when both revisions were parsed as structured
+	// documents and Changes holds their key path level differences
+	Structural bool
+	Changes    []KeyChange
+}
+
+// DiffConfig compares two revisions of a configuration file. A to revision
+// of zero or less compares against the latest revision.
+func (cm *ConfigManager) DiffConfig(ctx context.Context, caller Caller, namespace, filename string, from, to int) (*Diff, error) {
+	namespace, err := cm.authorizeFile(ctx, caller, namespace, filename, AccessRead)
+	if err != nil {
+		return nil, err
+	}
+
+	oldData, oldRevision, err := cm.store.GetRevision(ctx, namespace, filename, from)
+	if err != nil {
+		return nil, err
+	}
+
+	var newData []byte
+	var newType FileType
+	if to <= 0 {
+		var file *ConfigFile
+		newData, file, err = cm.store.Get(ctx, namespace, filename)
+		if err != nil {
+			return nil, err
+		}
+		to, newType = file.Revision, file.FileType
+	} else {
+		var revision *Revision
+		newData, revision, err = cm.store.GetRevision(ctx, namespace, filename, to)
+		if err != nil {
+			return nil, err
+		}
+		newType = revision.FileType
+	}
+
+	unified, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(oldData)),
+		B:        difflib.SplitLines(string(newData)),
+		FromFile: fmt.Sprintf("%s@%d", filename, from),
+		ToFile:   fmt.Sprintf("%s@%d", filename, to),
+		Context:  3,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &Diff{
+		From:    from,
+		To:      to,
+		Unified: unified,
+	}
+
+	oldDoc, oldErr := parseStructured(storedFileType(oldRevision.FileType, filename), oldData)
+	newDoc, newErr := parseStructured(storedFileType(newType, filename), newData)
+	if oldErr == nil && newErr == nil && oldDoc != nil && newDoc != nil {
+		diff.Structural = true
+		diff.Changes = structuralDiff("", oldDoc, newDoc, nil)
+	}
+
+	return diff, nil
+}
+
+// parseStructured decodes structured files into generic values, holding
+// the numbers of all file types as float64. Other file types yield a nil
+// document.
+func parseStructured(fileType FileType, data []byte) (interface{}, error) {
+	if !fileType.structured() {
+		return nil, nil
+	}
+	doc, err := decodeDocument(fileType, data)
+	if err != nil || doc == nil {
+		return nil, err
+	}
+	return jsonValues(doc, false)
+}
+
+// normalizeYAML converts the map[interface{}]interface{} values produced by
+// the YAML decoder into map[string]interface{} so documents compare and
+// encode like JSON
+func normalizeYAML(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			m[fmt.Sprint(key)] = normalizeYAML(item)
+		}
+		return m
+	case []interface{}:
+		for i, item := range v {
+			v[i] = normalizeYAML(item)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// structuralDiff appends the key path level differences between a and b to
+// changes
+func structuralDiff(path string, a, b interface{}, changes []KeyChange) []KeyChange {
+	switch av := a.(type) {
+	case map[string]interface{}:
+		bv, ok := b.(map[string]interface{})
+		if !ok {
+			break
+		}
+
+		keys := make([]string, 0, len(av)+len(bv))
+		for key := range av {
+			keys = append(keys, key)
+		}
+		for key := range bv {
+			if _, ok := av[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			child := key
+			if path != "" {
+				child = path + "." + key
+			}
+			oldValue, inOld := av[key]
+			newValue, inNew := bv[key]
+			switch {
+			case !inOld:
+				changes = append(changes, KeyChange{Path: child, Kind: ChangeAdded, NewValue: encodeValue(newValue)})
+			case !inNew:
+				changes = append(changes, KeyChange{Path: child, Kind: ChangeRemoved, OldValue: encodeValue(oldValue)})
+			default:
+				changes = structuralDiff(child, oldValue, newValue, changes)
+			}
+		}
+		return changes
+
+	case []interface{}:
+		bv, ok := b.([]interface{})
+		if !ok {
+			break
+		}
+
+		for i := 0; i < len(av) || i < len(bv); i++ {
+			child := path + "[" + strconv.Itoa(i) + "]"
+			switch {
+			case i >= len(av):
+				changes = append(changes, KeyChange{Path: child, Kind: ChangeAdded, NewValue: encodeValue(bv[i])})
+			case i >= len(bv):
+				changes = append(changes, KeyChange{Path: child, Kind: ChangeRemoved, OldValue: encodeValue(av[i])})
+			default:
+				changes = structuralDiff(child, av[i], bv[i], changes)
+			}
+		}
+		return changes
+	}
+
+	if !reflect.DeepEqual(a, b) {
+		changes = append(changes, KeyChange{
+			Path:     path,
+			Kind:     ChangeModified,
+			OldValue: encodeValue(a),
+			NewValue: encodeValue(b),
+		})
+	}
+	return changes
+}
+
+// encodeValue renders a document value as JSON
+func encodeValue(value interface{}) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
@@ -0,0 +1,272 @@
+package configurations
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestStructuralDiff(t *testing.T) {
+	tests := []struct {
+		name string
+		old  string
+		new  string
+		want []KeyChange
+	}{
+		{
+			name: "key order does not matter",
+			old:  `{"a": 1, "b": [1, {"c": true}]}`,
+			new:  `{"b": [1, {"c": true}], "a": 1}`,
+			want: nil,
+		},
+		{
+			name: "changes are sorted by key",
+			old:  `{"z": 1, "b": "x", "c": {"d": null}}`,
+			new:  `{"z": 2, "c": {"d": null, "e": [1]}, "a": {"g": "h"}}`,
+			want: []KeyChange{
+				{Path: "a", Kind: ChangeAdded, NewValue: `{"g":"h"}`},
+				{Path: "b", Kind: ChangeRemoved, OldValue: `"x"`},
+				{Path: "c.e", Kind: ChangeAdded, NewValue: "[1]"},
+				{Path: "z", Kind: ChangeModified, OldValue: "1", NewValue: "2"},
+			},
+		},
+		{
+			name: "arrays are compared by index",
+			old:  `{"a": [1, 2, 3], "b": [{"c": 1}]}`,
+			new:  `{"a": [1, 5], "b": [{"c": 2}, {"c": 3}]}`,
+			want: []KeyChange{
+				{Path: "a[1]", Kind: ChangeModified, OldValue: "2", NewValue: "5"},
+				{Path: "a[2]", Kind: ChangeRemoved, OldValue: "3"},
+				{Path: "b[0].c", Kind: ChangeModified, OldValue: "1", NewValue: "2"},
+				{Path: "b[1]", Kind: ChangeAdded, NewValue: `{"c":3}`},
+			},
+		},
+		{
+			name: "an inserted element shifts the following ones",
+			old:  `{"a": ["x", "y"]}`,
+			new:  `{"a": ["w", "x", "y"]}`,
+			want: []KeyChange{
+				{Path: "a[0]", Kind: ChangeModified, OldValue: `"x"`, NewValue: `"w"`},
+				{Path: "a[1]", Kind: ChangeModified, OldValue: `"y"`, NewValue: `"x"`},
+				{Path: "a[2]", Kind: ChangeAdded, NewValue: `"y"`},
+			},
+		},
+		{
+			name: "a changed type replaces the whole value",
+			old:  `{"a": {"b": 1}, "c": [1], "d": "1", "e": null}`,
+			new:  `{"a": [1], "c": {"b": 1}, "d": 1, "e": false}`,
+			want: []KeyChange{
+				{Path: "a", Kind: ChangeModified, OldValue: `{"b":1}`, NewValue: "[1]"},
+				{Path: "c", Kind: ChangeModified, OldValue: "[1]", NewValue: `{"b":1}`},
+				{Path: "d", Kind: ChangeModified, OldValue: `"1"`, NewValue: "1"},
+				{Path: "e", Kind: ChangeModified, OldValue: "null", NewValue: "false"},
+			},
+		},
+		{
+			name: "dotted keys are not split",
+			old:  `{"a.b": 1}`,
+			new:  `{"a.b": 2}`,
+			want: []KeyChange{
+				{Path: "a.b", Kind: ChangeModified, OldValue: "1", NewValue: "2"},
+			},
+		},
+		{
+			name: "top-level arrays",
+			old:  `[1]`,
+			new:  `[]`,
+			want: []KeyChange{{Path: "[0]", Kind: ChangeRemoved, OldValue: "1"}},
+		},
+		{
+			name: "top-level scalars have an empty path",
+			old:  `"a"`,
+			new:  `"b"`,
+			want: []KeyChange{{Path: "", Kind: ChangeModified, OldValue: `"a"`, NewValue: `"b"`}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var oldDoc, newDoc interface{}
+			if err := json.Unmarshal([]byte(test.old), &oldDoc); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal([]byte(test.new), &newDoc); err != nil {
+				t.Fatal(err)
+			}
+			if got := structuralDiff("", oldDoc, newDoc, nil); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+// storeRevisions writes every content as the next revision of a file
+func storeRevisions(t *testing.T, cm *ConfigManager, filename string, fileType FileType, contents ...string) {
+	t.Helper()
+	ctx := context.Background()
+	caller := Caller{UserID: "u"}
+	for i, data := range contents {
+		var err error
+		if i == 0 {
+			_, err = cm.AddConfig(ctx, caller, "", filename, fileType, []byte(data))
+		} else {
+			_, err = cm.UpdateConfig(ctx, caller, "", filename, fileType, []byte(data), Precondition{})
+		}
+		if err != nil {
+			t.Fatalf("storing revision %d: %v", i+1, err)
+		}
+	}
+}
+
+func TestDiffConfig(t *testing.T) {
+	tests := []struct {
+		name       string
+		filename   string
+		fileType   FileType
+		revisions  []string
+		from, to   int
+		wantTo     int
+		structural bool
+		changes    []KeyChange
+		unified    []string
+	}{
+		{
+			name:       "latest revision by default",
+			filename:   "app.json",
+			revisions:  []string{"{\"port\": 80}\n", "{\"port\": 81}\n", "{\"port\": 8080}\n"},
+			from:       1,
+			wantTo:     3,
+			structural: true,
+			changes:    []KeyChange{{Path: "port", Kind: ChangeModified, OldValue: "80", NewValue: "8080"}},
+			unified:    []string{"--- app.json@1", "+++ app.json@3", "-{\"port\": 80}", "+{\"port\": 8080}"},
+		},
+		{
+			name:       "explicit range",
+			filename:   "app.json",
+			revisions:  []string{"{\"port\": 80}\n", "{\"port\": 81}\n", "{\"port\": 8080}\n"},
+			from:       2,
+			to:         3,
+			wantTo:     3,
+			structural: true,
+			changes:    []KeyChange{{Path: "port", Kind: ChangeModified, OldValue: "81", NewValue: "8080"}},
+			unified:    []string{"--- app.json@2"},
+		},
+		{
+			name:       "backwards range",
+			filename:   "app.json",
+			revisions:  []string{"{}\n", "{\"a\": 1}\n"},
+			from:       2,
+			to:         1,
+			wantTo:     1,
+			structural: true,
+			changes:    []KeyChange{{Path: "a", Kind: ChangeRemoved, OldValue: "1"}},
+			unified:    []string{"-{\"a\": 1}"},
+		},
+		{
+			name:       "formatting only changes the unified diff",
+			filename:   "app.yaml",
+			revisions:  []string{"a: 1\n", "a:   1 # comment\n"},
+			from:       1,
+			wantTo:     2,
+			structural: true,
+			unified:    []string{"+a:   1 # comment"},
+		},
+		{
+			name:       "yaml keys that are not strings",
+			filename:   "app.yml",
+			revisions:  []string{"ports:\n  80: http\n", "ports:\n  80: http\n  443: https\n"},
+			from:       1,
+			wantTo:     2,
+			structural: true,
+			changes:    []KeyChange{{Path: "ports.443", Kind: ChangeAdded, NewValue: `"https"`}},
+		},
+		{
+			name:      "revisions stored as text are not structural",
+			filename:  "app.json",
+			fileType:  FileTypeTXT,
+			revisions: []string{"{\"a\": 1}\n", "{\"a\": 2}\n"},
+			from:      1,
+			wantTo:    2,
+			unified:   []string{"-{\"a\": 1}", "+{\"a\": 2}"},
+		},
+		{
+			name:       "toml tables",
+			filename:   "app.toml",
+			revisions:  []string{"[db]\nport = 1\n", "[db]\nport = 2\nhost = 'x'\n"},
+			from:       1,
+			wantTo:     2,
+			structural: true,
+			changes: []KeyChange{
+				{Path: "db.host", Kind: ChangeAdded, NewValue: `"x"`},
+				{Path: "db.port", Kind: ChangeModified, OldValue: "1", NewValue: "2"},
+			},
+		},
+		{
+			name:       "properties",
+			filename:   "app.properties",
+			revisions:  []string{"db.host=a\n", "db.host=b\n"},
+			from:       1,
+			wantTo:     2,
+			structural: true,
+			changes:    []KeyChange{{Path: "db.host", Kind: ChangeModified, OldValue: `"a"`, NewValue: `"b"`}},
+		},
+		{
+			name:      "text is not structural",
+			filename:  "notes.txt",
+			revisions: []string{"one\n", "two\n"},
+			from:      1,
+			wantTo:    2,
+			unified:   []string{"-one", "+two"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := NewConfigManager(NewFileStore(t.TempDir()))
+			storeRevisions(t, cm, test.filename, test.fileType, test.revisions...)
+
+			diff, err := cm.DiffConfig(context.Background(), Caller{UserID: "u"}, "", test.filename, test.from, test.to)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff.From != test.from || diff.To != test.wantTo {
+				t.Errorf("revisions = %d..%d, want %d..%d", diff.From, diff.To, test.from, test.wantTo)
+			}
+			if diff.Structural != test.structural || !reflect.DeepEqual(diff.Changes, test.changes) {
+				t.Errorf("structural = %v, changes = %+v; want %v, %+v", diff.Structural, diff.Changes, test.structural, test.changes)
+			}
+			for _, line := range test.unified {
+				if !strings.Contains(diff.Unified, line+"\n") {
+					t.Errorf("unified diff does not contain %q:\n%s", line, diff.Unified)
+				}
+			}
+		})
+	}
+}
+
+func TestDiffConfigErrors(t *testing.T) {
+	cm := NewConfigManager(NewFileStore(t.TempDir()))
+	storeRevisions(t, cm, "app.json", FileTypeUnspecified, "{}")
+
+	tests := []struct {
+		name     string
+		caller   string
+		filename string
+		from, to int
+		want     error
+	}{
+		{name: "unknown from revision", caller: "u", filename: "app.json", from: 2, want: ErrRevisionNotFound},
+		{name: "unknown to revision", caller: "u", filename: "app.json", from: 1, to: 5, want: ErrRevisionNotFound},
+		{name: "unknown file", caller: "u", filename: "other.json", from: 1, want: ErrFileNotFound},
+		{name: "file of another user", caller: "v", filename: "app.json", from: 1, want: ErrAccessDenied},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := cm.DiffConfig(context.Background(), Caller{UserID: test.caller}, "u", test.filename, test.from, test.to)
+			if !errors.Is(err, test.want) {
+				t.Errorf("got error %v, want %v", err, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,240 @@
+package configurations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrPathConflict is returned when a file would be stored below another file
+// or at the path of a directory
+var ErrPathConflict = errors.New("path conflicts with an existing file or directory")
+
+// DirEntry is an entry of a configuration directory. File is set for files
+// and nil for subdirectories.
+type DirEntry struct {
+	Name  string
+	Path  string
+	IsDir bool
+	File  *ConfigFile
+}
+
+// ListDir returns the files and subdirectories directly below dir. The
+// empty path lists the root directory of the namespace.
+func (cm *ConfigManager) ListDir(ctx context.Context, caller Caller, namespace, dir string) ([]*DirEntry, error) {
+	namespace, err := authorizeNamespace(caller, namespace)
+	if err != nil {
+		return nil, err
+	}
+	dir, err = validateDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	prefix := dirPrefix(dir)
+
+	files, err := cm.store.List(ctx, namespace, ListOptions{Prefix: prefix})
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 && dir != "" {
+		return nil, ErrFileNotFound
+	}
+
+	var entries []*DirEntry
+	for _, file := range files {
+		name := strings.TrimPrefix(file.Filename, prefix)
+		if i := strings.IndexByte(name, '/'); i >= 0 {
+			// Files are ordered by name, so all files of a subdirectory
+			// follow each other
+			name = name[:i]
+			if n := len(entries); n > 0 && entries[n-1].IsDir && entries[n-1].Name == name {
+				continue
+			}
+			entries = append(entries, &DirEntry{Name: name, Path: prefix + name, IsDir: true})
+			continue
+		}
+		entries = append(entries, &DirEntry{Name: name, Path: file.Filename, File: file})
+	}
+
+	return entries, nil
+}
+
+// DeleteDir removes every file below dir together with its history and
+// returns the number of files deleted
+func (cm *ConfigManager) DeleteDir(ctx context.Context, caller Caller, namespace, dir string) (int, error) {
+	namespace, err := authorizeNamespace(caller, namespace)
+	if err != nil {
+		return 0, err
+	}
+	dir, err = validateDir(dir)
+	if err != nil {
+		return 0, err
+	}
+	if dir == "" {
+		return 0, fmt.Errorf("%w: cannot delete the root directory", ErrInvalidPath)
+	}
+
+	files, err := cm.store.List(ctx, namespace, ListOptions{Prefix: dirPrefix(dir)})
+	if err != nil {
+		return 0, err
+	}
+	if len(files) == 0 {
+		return 0, ErrFileNotFound
+	}
+
+	deleted := 0
+	for _, file := range files {
+		err := cm.store.Delete(ctx, namespace, file.Filename, Precondition{})
+		if err != nil && !errors.Is(err, ErrFileNotFound) {
+			return deleted, err
+		}
+		cm.publishDelete(namespace, file.Filename)
+		deleted++
+	}
+	return deleted, nil
+}
+
+// MoveConfig renames a file, or moves every file below a directory, keeping
+// the revision history of each file. Files must match the schemas
+// registered for their new names. The files of a directory are moved one at
+// a time; when one of them fails, the files already moved are moved back.
+func (cm *ConfigManager) MoveConfig(ctx context.Context, caller Caller, namespace, from, to string) error {
+	namespace, err := authorizeNamespace(caller, namespace)
+	if err != nil {
+		return err
+	}
+	from, err = validateDir(from)
+	if err != nil {
+		return err
+	}
+	to, err = validateDir(to)
+	if err != nil {
+		return err
+	}
+	if from == "" || to == "" {
+		return fmt.Errorf("%w: cannot move the root directory", ErrInvalidPath)
+	}
+	if from == to {
+		return nil
+	}
+
+	if _, err := cm.store.Stat(ctx, namespace, from); err == nil {
+		if err := cm.checkPathConflict(ctx, namespace, to); err != nil {
+			return err
+		}
+		cond, err := cm.checkDestination(ctx, namespace, from, to)
+		if err != nil {
+			return err
+		}
+		return cm.rename(ctx, namespace, from, to, cond)
+	} else if !errors.Is(err, ErrFileNotFound) {
+		return err
+	}
+
+	if strings.HasPrefix(to, dirPrefix(from)) {
+		return fmt.Errorf("%w: cannot move a directory into itself", ErrPathConflict)
+	}
+
+	files, err := cm.store.List(ctx, namespace, ListOptions{Prefix: dirPrefix(from)})
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return ErrFileNotFound
+	}
+
+	// Check every destination before moving anything
+	targets := make([]string, len(files))
+	conds := make([]Precondition, len(files))
+	for i, file := range files {
+		targets[i] = to + strings.TrimPrefix(file.Filename, from)
+		if err := cm.checkPathConflict(ctx, namespace, targets[i]); err != nil {
+			return err
+		}
+		if conds[i], err = cm.checkDestination(ctx, namespace, file.Filename, targets[i]); err != nil {
+			return err
+		}
+	}
+	for i, file := range files {
+		if err := cm.rename(ctx, namespace, file.Filename, targets[i], conds[i]); err != nil {
+			return errors.Join(err, cm.undoMoves(ctx, namespace, files[:i], targets[:i]))
+		}
+	}
+	return nil
+}
+
+// undoMoves moves files back from their targets, last first, and returns
+// the errors of the files it could not move back
+func (cm *ConfigManager) undoMoves(ctx context.Context, namespace string, files []*ConfigFile, targets []string) error {
+	var errs []error
+	for i := len(files) - 1; i >= 0; i-- {
+		if err := cm.rename(ctx, namespace, targets[i], files[i].Filename, Precondition{}); err != nil {
+			errs = append(errs, fmt.Errorf("cannot move %s back: %w", targets[i], err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// checkDestination validates the content of a file that is about to be
+// moved against its type and the schemas of its new name. The returned
+// precondition fails the move if the file changes in the meantime.
+func (cm *ConfigManager) checkDestination(ctx context.Context, namespace, from, to string) (Precondition, error) {
+	data, file, err := cm.store.Get(ctx, namespace, from)
+	if err != nil {
+		return Precondition{}, err
+	}
+	fileType, err := resolveFileType(file.FileType, to, data)
+	if err != nil {
+		return Precondition{}, err
+	}
+	if err := cm.validateSchemas(ctx, namespace, to, fileType, data); err != nil {
+		return Precondition{}, err
+	}
+	return Precondition{ETags: []string{file.Hash}}, nil
+}
+
+// rename moves a file and notifies the watchers of both filenames
+func (cm *ConfigManager) rename(ctx context.Context, namespace, from, to string, cond Precondition) error {
+	if err := cm.store.Rename(ctx, namespace, from, to, cond); err != nil {
+		return err
+	}
+	cm.publishDelete(namespace, from)
+
+	revisions, err := cm.store.Revisions(ctx, namespace, to)
+	if err != nil {
+		return err
+	}
+	cm.publishUpdate(namespace, to, revisions[len(revisions)-1])
+	return nil
+}
+
+// checkPathConflict verifies that a new file can be stored at filename: no
+// file may exist at filename or at one of its parent directories, and
+// filename must not already be a directory
+func (cm *ConfigManager) checkPathConflict(ctx context.Context, userID, filename string) error {
+	if _, err := cm.store.Stat(ctx, userID, filename); err == nil {
+		return ErrFileExists
+	} else if !errors.Is(err, ErrFileNotFound) {
+		return err
+	}
+
+	for _, dir := range parentDirs(filename) {
+		_, err := cm.store.Stat(ctx, userID, dir)
+		if err == nil {
+			return fmt.Errorf("%w: %s is a file", ErrPathConflict, dir)
+		}
+		if !errors.Is(err, ErrFileNotFound) {
+			return err
+		}
+	}
+
+	files, err := cm.store.List(ctx, userID, ListOptions{Prefix: dirPrefix(filename), Limit: 1})
+	if err != nil {
+		return err
+	}
+	if len(files) > 0 {
+		return fmt.Errorf("%w: %s is a directory", ErrPathConflict, filename)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package configurations
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+var errRenameFailed = errors.New("rename failed")
+
+// failingRenameStore fails renaming the file named failFrom
+type failingRenameStore struct {
+	*FileStore
+	failFrom string
+}
+
+func (s *failingRenameStore) Rename(ctx context.Context, userID, from, to string, cond Precondition) error {
+	if from == s.failFrom {
+		return errRenameFailed
+	}
+	return s.FileStore.Rename(ctx, userID, from, to, cond)
+}
+
+func TestMoveConfigDirectory(t *testing.T) {
+	ctx := context.Background()
+	cm := NewConfigManager(NewFileStore(t.TempDir()))
+	caller := Caller{UserID: "u"}
+	for _, filename := range []string{"dir/a.json", "dir/sub/b.json"} {
+		if _, err := cm.AddConfig(ctx, caller, "", filename, FileTypeUnspecified, []byte(`{"v": 1}`)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := cm.MoveConfig(ctx, caller, "", "dir", "moved"); err != nil {
+		t.Fatal(err)
+	}
+	for _, filename := range []string{"moved/a.json", "moved/sub/b.json"} {
+		if _, err := cm.store.Stat(ctx, "u", filename); err != nil {
+			t.Errorf("Stat(%s): %v", filename, err)
+		}
+	}
+	if files, err := cm.store.List(ctx, "u", ListOptions{Prefix: "dir/"}); err != nil || len(files) > 0 {
+		t.Errorf("List(dir/) = %d files, %v; want none", len(files), err)
+	}
+}
+
+func TestMoveConfigDirectoryRollback(t *testing.T) {
+	ctx := context.Background()
+	store := &failingRenameStore{FileStore: NewFileStore(t.TempDir()), failFrom: "dir/c.json"}
+	cm := NewConfigManager(store)
+	caller := Caller{UserID: "u"}
+	filenames := []string{"dir/a.json", "dir/b.json", "dir/c.json"}
+	for _, filename := range filenames {
+		if _, err := cm.AddConfig(ctx, caller, "", filename, FileTypeUnspecified, []byte(`{"v": 1}`)); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := cm.UpdateConfig(ctx, caller, "", filename, FileTypeUnspecified, []byte(`{"v": 2}`), Precondition{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := cm.MoveConfig(ctx, caller, "", "dir", "moved"); !errors.Is(err, errRenameFailed) {
+		t.Fatalf("MoveConfig = %v, want %v", err, errRenameFailed)
+	}
+
+	// The files moved before c.json are back with their history
+	for _, filename := range filenames {
+		data, file, err := cm.store.Get(ctx, "u", filename)
+		if err != nil || string(data) != `{"v": 2}` || file.Revision != 2 {
+			t.Errorf("Get(%s) = %q, %+v, %v; want revision 2", filename, data, file, err)
+		}
+		if revisions, err := cm.store.Revisions(ctx, "u", filename); err != nil || len(revisions) != 2 {
+			t.Errorf("Revisions(%s) = %d revisions, %v; want 2", filename, len(revisions), err)
+		}
+	}
+	if files, err := cm.store.List(ctx, "u", ListOptions{Prefix: "moved/"}); err != nil || len(files) > 0 {
+		t.Errorf("List(moved/) = %d files, %v; want none", len(files), err)
+	}
+}
@@ -0,0 +1,221 @@
+package configurations
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/fsnotify/fsnotify"
+	"k8s.io/klog/v2"
+)
+
+// fileWatcher follows the configuration files below the root of a FileStore
+// through file system notifications
+type fileWatcher struct {
+	store   *FileStore
+	watcher *fsnotify.Watcher
+	publish func(*Event)
+	// files holds the paths of the configuration files seen so far
+	files map[string]struct{}
+}
+
+// Notify reports the changes of the files below the store root made by any
+// process sharing it. Writes rename the current content of a file into
+// place after committing its revision, so the file appearing is reported as
+// an update and its disappearance as a deletion.
+func (s *FileStore) Notify(ctx context.Context, ready func(), publish func(*Event)) error {
+	if err := os.MkdirAll(s.configDir, 0755); err != nil {
+		return err
+	}
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+
+	w := &fileWatcher{
+		store:   s,
+		watcher: watcher,
+		publish: publish,
+		files:   make(map[string]struct{}),
+	}
+	if err := w.add(ctx, s.configDir, false); err != nil {
+		return err
+	}
+	ready()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event := <-watcher.Events:
+			if err := w.handle(ctx, event); err != nil {
+				return err
+			}
+		case err := <-watcher.Errors:
+			if !errors.Is(err, fsnotify.ErrEventOverflow) {
+				return err
+			}
+			// Events were dropped, so every file is compared with what
+			// was reported before
+			if err := w.resync(ctx); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// add watches dir and its subdirectories and records the files in them,
+// reporting each of them when publish is set. The history and schema
+// directories are skipped since revisions only become visible with their
+// file.
+func (w *fileWatcher) add(ctx context.Context, dir string, publish bool) error {
+	return filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			// Directories may disappear while they are walked
+			if isNotExist(err) {
+				return nil
+			}
+			return err
+		}
+
+		if d.IsDir() {
+			if filepath.Dir(path) == filepath.Clean(w.store.configDir) && strings.HasPrefix(d.Name(), ".") {
+				return filepath.SkipDir
+			}
+			// The directory is watched before its entries are listed, so
+			// no file created in the meantime is missed
+			if err := w.watcher.Add(path); err != nil && !isNotExist(err) {
+				return err
+			}
+			return nil
+		}
+
+		if _, _, ok := w.file(path); !ok {
+			return nil
+		}
+		w.files[path] = struct{}{}
+		if publish {
+			w.update(ctx, path)
+		}
+		return nil
+	})
+}
+
+// handle reports the change of a single path
+func (w *fileWatcher) handle(ctx context.Context, event fsnotify.Event) error {
+	if event.Has(fsnotify.Remove) || event.Has(fsnotify.Rename) {
+		w.remove(event.Name)
+	}
+	if !event.Has(fsnotify.Create) {
+		return nil
+	}
+
+	info, err := os.Lstat(event.Name)
+	if err != nil {
+		if isNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	if info.IsDir() {
+		return w.add(ctx, event.Name, true)
+	}
+
+	if _, _, ok := w.file(event.Name); ok {
+		w.files[event.Name] = struct{}{}
+		w.update(ctx, event.Name)
+	}
+	return nil
+}
+
+// update publishes the latest revision of the file at path
+func (w *fileWatcher) update(ctx context.Context, path string) {
+	namespace, filename, _ := w.file(path)
+
+	revisions, err := w.store.Revisions(ctx, namespace, filename)
+	if err != nil {
+		// The file was removed again, which is reported separately
+		if !errors.Is(err, ErrFileNotFound) {
+			klog.FromContext(ctx).Error(err, "cannot read revisions", "namespace", namespace, "filename", filename)
+		}
+		return
+	}
+
+	w.publish(&Event{
+		Type:      EventUpdated,
+		Namespace: namespace,
+		Filename:  filename,
+		Revision:  revisions[len(revisions)-1],
+	})
+}
+
+// remove publishes the deletion of the file at path, or of every file
+// below it when path was a directory
+func (w *fileWatcher) remove(path string) {
+	for _, watched := range w.watcher.WatchList() {
+		if watched == path || strings.HasPrefix(watched, path+string(filepath.Separator)) {
+			w.watcher.Remove(watched)
+		}
+	}
+
+	for file := range w.files {
+		if file != path && !strings.HasPrefix(file, path+string(filepath.Separator)) {
+			continue
+		}
+		delete(w.files, file)
+
+		namespace, filename, _ := w.file(file)
+		w.publish(&Event{
+			Type:      EventDeleted,
+			Namespace: namespace,
+			Filename:  filename,
+		})
+	}
+}
+
+// resync walks the whole store again, publishing the latest revision of
+// every file and the deletion of the files that disappeared
+func (w *fileWatcher) resync(ctx context.Context) error {
+	previous := w.files
+	w.files = make(map[string]struct{}, len(previous))
+	if err := w.add(ctx, w.store.configDir, true); err != nil {
+		return err
+	}
+
+	for path := range previous {
+		if _, ok := w.files[path]; ok {
+			continue
+		}
+		namespace, filename, _ := w.file(path)
+		w.publish(&Event{
+			Type:      EventDeleted,
+			Namespace: namespace,
+			Filename:  filename,
+		})
+	}
+	return nil
+}
+
+// file returns the namespace and filename of a configuration file path.
+// ok is false for paths outside of a namespace and for staged files.
+func (w *fileWatcher) file(path string) (namespace, filename string, ok bool) {
+	if isStagedFile(filepath.Base(path)) {
+		return "", "", false
+	}
+	rel, err := filepath.Rel(w.store.configDir, path)
+	if err != nil {
+		return "", "", false
+	}
+
+	parts := strings.SplitN(filepath.ToSlash(rel), "/", 2)
+	// Namespaces never start with a dot, unlike the directories of the
+	// store itself
+	if len(parts) != 2 || strings.HasPrefix(parts[0], ".") {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
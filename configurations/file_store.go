@@ -0,0 +1,700 @@
+package configurations
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// historyDirName is the directory below the store root that keeps the
+// revision history of every file
+const historyDirName = ".history"
+
+// revisionIndexName is the name of the file listing a file's revisions
+const revisionIndexName = "revisions.json"
+
+// schemasDirName is the directory below the store root that keeps the
+// schemas of every namespace in <namespace>.json
+const schemasDirName = ".schemas"
+
+// grantsName is the name of the file listing a file's access grants, which
+// is kept next to its revisions so it moves and disappears with them
+const grantsName = "grants.json"
+
+// FileStore stores configuration files on the local filesystem, one
+// directory per user below a root directory. The latest content of a file
+// lives at <root>/<user>/<filename>; every revision is also kept below
+// <root>/.history/<user>/<filename>/, together with the access grants of the
+// file.
+type FileStore struct {
+	configDir string
+	// mu serializes writes so revision numbers are assigned in order
+	mu sync.Mutex
+}
+
+// NewFileStore creates a new filesystem backed store rooted at configDir
+func NewFileStore(configDir string) *FileStore {
+	if configDir == "" {
+		configDir = "configurations"
+	}
+	return &FileStore{configDir: configDir}
+}
+
+// revisionIndex is the on-disk list of a file's revisions
+type revisionIndex struct {
+	Revisions []*Revision `json:"revisions"`
+}
+
+// path returns the location of a user's file on disk
+func (s *FileStore) path(userID, filename string) string {
+	return filepath.Join(s.configDir, userID, filename)
+}
+
+// historyPath returns the directory holding the revisions of a user's file
+func (s *FileStore) historyPath(userID, filename string) string {
+	return filepath.Join(s.configDir, historyDirName, userID, filename)
+}
+
+// revisionPath returns the location of a stored revision on disk
+func (s *FileStore) revisionPath(userID, filename string, number int) string {
+	return filepath.Join(s.historyPath(userID, filename), strconv.Itoa(number))
+}
+
+// Put writes data as the next revision of the file and makes it the
+// current content. Every file is staged next to its destination and renamed
+// into place; the rename of the revision index commits the revision, so a
+// failed write leaves the previous revision intact.
+func (s *FileStore) Put(ctx context.Context, file *ConfigFile, rev *Revision, data []byte, cond Precondition) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.checkPrecondition(ctx, file.UserID, file.Filename, cond); err != nil {
+		return err
+	}
+
+	filePath := s.path(file.UserID, file.Filename)
+	historyPath := s.historyPath(file.UserID, file.Filename)
+
+	index, persisted, err := s.loadIndex(file.UserID, file.Filename)
+	if err != nil {
+		return err
+	}
+
+	// Create user and history directories if they don't exist
+	if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(historyPath, 0755); err != nil {
+		return err
+	}
+
+	// Keep the content of a file written before history was tracked
+	if !persisted && len(index.Revisions) > 0 {
+		legacy, err := os.ReadFile(filePath)
+		if err != nil {
+			return err
+		}
+		if err := writeFileAtomic(s.revisionPath(file.UserID, file.Filename, 1), legacy); err != nil {
+			return err
+		}
+	}
+
+	rev.Number = 1
+	rev.Hash = contentHash(data)
+	rev.Size = int64(len(data))
+	rev.FileType = file.FileType
+	rev.CreatedAt = time.Now()
+	if n := len(index.Revisions); n > 0 {
+		rev.Number = index.Revisions[n-1].Number + 1
+	}
+
+	index.Revisions = append(index.Revisions, rev)
+	indexData, err := json.Marshal(index)
+	if err != nil {
+		return err
+	}
+
+	staged := []struct {
+		path string
+		data []byte
+	}{
+		{s.revisionPath(file.UserID, file.Filename, rev.Number), data},
+		{filepath.Join(historyPath, revisionIndexName), indexData},
+		{filePath, data},
+	}
+	tmpPaths := make([]string, 0, len(staged))
+	defer func() {
+		for _, tmpPath := range tmpPaths {
+			os.Remove(tmpPath)
+		}
+	}()
+	for _, f := range staged {
+		tmpPath, err := stageFile(f.path, f.data)
+		if err != nil {
+			return err
+		}
+		tmpPaths = append(tmpPaths, tmpPath)
+	}
+
+	for i, f := range staged {
+		if err := os.Rename(tmpPaths[i], f.path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkPrecondition evaluates cond against the latest revision of a file
+func (s *FileStore) checkPrecondition(ctx context.Context, userID, filename string, cond Precondition) error {
+	current, err := s.Stat(ctx, userID, filename)
+	if errors.Is(err, ErrFileNotFound) {
+		current, err = nil, nil
+	}
+	if err != nil {
+		return err
+	}
+	return cond.check(current)
+}
+
+// stageFile writes data to a temporary file in the directory of path and
+// returns the temporary file's name
+func stageFile(path string, data []byte) (string, error) {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return "", err
+	}
+
+	_, err = tmp.Write(data)
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tmp.Name(), 0644)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return "", err
+	}
+
+	return tmp.Name(), nil
+}
+
+// isStagedFile reports whether name is a temporary file left behind by an
+// interrupted write
+func isStagedFile(name string) bool {
+	return strings.HasPrefix(name, ".") && strings.Contains(name, ".tmp-")
+}
+
+// writeFileAtomic replaces the file at path with data by renaming a staged
+// temporary file over it
+func writeFileAtomic(path string, data []byte) error {
+	tmpPath, err := stageFile(path, data)
+	if err != nil {
+		return err
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	return nil
+}
+
+// Get reads the latest committed revision of a file
+func (s *FileStore) Get(ctx context.Context, userID, filename string) ([]byte, *ConfigFile, error) {
+	file, err := s.Stat(ctx, userID, filename)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data, _, err := s.GetRevision(ctx, userID, filename, file.Revision)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return data, file, nil
+}
+
+// Delete removes a file and its history from disk
+func (s *FileStore) Delete(ctx context.Context, userID, filename string, cond Precondition) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	file, err := s.Stat(ctx, userID, filename)
+	if err != nil {
+		return err
+	}
+	if err := cond.check(file); err != nil {
+		return err
+	}
+
+	if err := os.Remove(s.path(userID, filename)); err != nil {
+		return err
+	}
+	if err := os.RemoveAll(s.historyPath(userID, filename)); err != nil {
+		return err
+	}
+
+	s.pruneEmptyDirs(userID, filename)
+	return nil
+}
+
+// Rename moves a file and its history directory to a new filename. The
+// moves are undone when one of them fails, so the file is never left without
+// its history.
+func (s *FileStore) Rename(ctx context.Context, userID, from, to string, cond Precondition) (err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	file, err := s.Stat(ctx, userID, from)
+	if err != nil {
+		return err
+	}
+	if err := cond.check(file); err != nil {
+		return err
+	}
+	if _, err := os.Stat(s.path(userID, to)); err == nil {
+		return ErrFileExists
+	} else if !isNotExist(err) {
+		return err
+	}
+
+	// The history moves first so the file appears under its new name with
+	// its revisions in place, which file system notifications rely on.
+	// Files written before history was tracked have no history directory.
+	type move struct{ from, to string }
+	moves := make([]move, 0, 2)
+	if _, err := os.Stat(s.historyPath(userID, from)); err == nil {
+		moves = append(moves, move{s.historyPath(userID, from), s.historyPath(userID, to)})
+	} else if !isNotExist(err) {
+		return err
+	}
+	moves = append(moves, move{s.path(userID, from), s.path(userID, to)})
+
+	moved := 0
+	defer func() {
+		if err == nil {
+			return
+		}
+		for i := moved - 1; i >= 0; i-- {
+			os.Rename(moves[i].to, moves[i].from)
+		}
+		s.pruneEmptyDirs(userID, to)
+	}()
+	for _, m := range moves {
+		if err := os.MkdirAll(filepath.Dir(m.to), 0755); err != nil {
+			return err
+		}
+		if err := os.Rename(m.from, m.to); err != nil {
+			return err
+		}
+		moved++
+	}
+
+	s.pruneEmptyDirs(userID, from)
+	return nil
+}
+
+// pruneEmptyDirs removes the directories of a removed file that were left
+// empty, up to the user's configuration and history directories
+func (s *FileStore) pruneEmptyDirs(userID, filename string) {
+	for _, root := range []string{s.path(userID, ""), s.historyPath(userID, "")} {
+		dir := filepath.Dir(filepath.Join(root, filename))
+		for dir != root && strings.HasPrefix(dir, root) {
+			// Removing fails once a directory is not empty
+			if err := os.Remove(dir); err != nil {
+				break
+			}
+			dir = filepath.Dir(dir)
+		}
+	}
+}
+
+// List walks the user's configuration directory
+func (s *FileStore) List(ctx context.Context, userID string, opts ListOptions) ([]*ConfigFile, error) {
+	userDir := filepath.Join(s.configDir, userID)
+
+	var filenames []string
+	err := filepath.WalkDir(userDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if isNotExist(err) && path == userDir {
+				return filepath.SkipDir
+			}
+			return err
+		}
+		if d.IsDir() || isStagedFile(d.Name()) {
+			return nil
+		}
+
+		rel, err := filepath.Rel(userDir, path)
+		if err != nil {
+			return err
+		}
+		if filename := filepath.ToSlash(rel); opts.matches(filename) {
+			filenames = append(filenames, filename)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(filenames)
+	if opts.Limit > 0 && len(filenames) > opts.Limit {
+		filenames = filenames[:opts.Limit]
+	}
+
+	files := make([]*ConfigFile, 0, len(filenames))
+	for _, filename := range filenames {
+		file, err := s.Stat(ctx, userID, filename)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+
+	return files, nil
+}
+
+// Stat returns the metadata of a file in the user's configuration directory
+func (s *FileStore) Stat(ctx context.Context, userID, filename string) (*ConfigFile, error) {
+	info, err := os.Stat(s.path(userID, filename))
+	if err != nil {
+		if isNotExist(err) {
+			return nil, ErrFileNotFound
+		}
+		return nil, err
+	}
+	if info.IsDir() {
+		return nil, ErrFileNotFound
+	}
+
+	index, _, err := s.loadIndex(userID, filename)
+	if err != nil {
+		return nil, err
+	}
+	if len(index.Revisions) == 0 {
+		return nil, ErrFileNotFound
+	}
+	first := index.Revisions[0]
+	latest := index.Revisions[len(index.Revisions)-1]
+
+	return &ConfigFile{
+		UserID:    userID,
+		Filename:  filename,
+		FileType:  latest.FileType,
+		Size:      info.Size(),
+		Revision:  latest.Number,
+		Hash:      latest.Hash,
+		UpdatedBy: latest.Author,
+		CreatedAt: first.CreatedAt,
+		UpdatedAt: latest.CreatedAt,
+	}, nil
+}
+
+// Revisions returns the revision history of a file
+func (s *FileStore) Revisions(ctx context.Context, userID, filename string) ([]*Revision, error) {
+	if _, err := os.Stat(s.path(userID, filename)); isNotExist(err) {
+		return nil, ErrFileNotFound
+	}
+
+	index, _, err := s.loadIndex(userID, filename)
+	if err != nil {
+		return nil, err
+	}
+	return index.Revisions, nil
+}
+
+// GetRevision reads a specific revision of a file from its history
+func (s *FileStore) GetRevision(ctx context.Context, userID, filename string, number int) ([]byte, *Revision, error) {
+	revisions, err := s.Revisions(ctx, userID, filename)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, rev := range revisions {
+		if rev.Number != number {
+			continue
+		}
+
+		data, err := os.ReadFile(s.revisionPath(userID, filename, number))
+		if isNotExist(err) && len(revisions) == 1 {
+			// Files written before history was tracked only have their
+			// current content
+			data, err = os.ReadFile(s.path(userID, filename))
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		return data, rev, nil
+	}
+
+	return nil, nil, ErrRevisionNotFound
+}
+
+// loadIndex reads the revision index of a file. A file that exists but was
+// written before history was tracked gets a single synthesized revision, in
+// which case persisted is false.
+func (s *FileStore) loadIndex(userID, filename string) (index *revisionIndex, persisted bool, err error) {
+	index = &revisionIndex{}
+
+	data, err := os.ReadFile(filepath.Join(s.historyPath(userID, filename), revisionIndexName))
+	if err == nil {
+		// Indexes written before file types had names hold the number the
+		// store derived from the extension, from 1 for txt to 5 for yaml,
+		// which is already a FileType value. The type is decoded apart
+		// from the revision because FileType.UnmarshalJSON reads numbers
+		// with the protobuf numbering. Writing the index again stores the
+		// names.
+		var stored struct {
+			Revisions []struct {
+				Revision
+				FileType json.RawMessage `json:"file_type"`
+			} `json:"revisions"`
+		}
+		if err := json.Unmarshal(data, &stored); err != nil {
+			return nil, false, err
+		}
+		for _, entry := range stored.Revisions {
+			rev := entry.Revision
+			var number int
+			switch {
+			case len(entry.FileType) == 0:
+			case json.Unmarshal(entry.FileType, &number) == nil:
+				rev.FileType = FileType(number)
+			default:
+				if err := json.Unmarshal(entry.FileType, &rev.FileType); err != nil {
+					return nil, false, err
+				}
+			}
+			rev.FileType = storedFileType(rev.FileType, filename)
+			index.Revisions = append(index.Revisions, &rev)
+		}
+		return index, true, nil
+	}
+	if !isNotExist(err) {
+		return nil, false, err
+	}
+
+	content, err := os.ReadFile(s.path(userID, filename))
+	if err != nil {
+		if isNotExist(err) {
+			return index, false, nil
+		}
+		return nil, false, err
+	}
+	info, err := os.Stat(s.path(userID, filename))
+	if err != nil {
+		return nil, false, err
+	}
+
+	index.Revisions = []*Revision{{
+		Number:    1,
+		Author:    userID,
+		Hash:      contentHash(content),
+		Size:      int64(len(content)),
+		FileType:  storedFileType(FileTypeUnspecified, filename),
+		CreatedAt: info.ModTime(),
+	}}
+	return index, false, nil
+}
+
+// Grants reads the access grants of a file
+func (s *FileStore) Grants(ctx context.Context, userID, filename string) ([]Grant, error) {
+	if _, err := s.Stat(ctx, userID, filename); err != nil {
+		return nil, err
+	}
+	return s.loadGrants(userID, filename)
+}
+
+// SetGrant rewrites the grants file of a file with the grant applied
+func (s *FileStore) SetGrant(ctx context.Context, userID, filename string, grant Grant) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.Stat(ctx, userID, filename); err != nil {
+		return err
+	}
+	grants, err := s.loadGrants(userID, filename)
+	if err != nil {
+		return err
+	}
+
+	updated := make([]Grant, 0, len(grants)+1)
+	for _, existing := range grants {
+		if existing.Grantee != grant.Grantee {
+			updated = append(updated, existing)
+		}
+	}
+	if grant.Access != AccessNone {
+		updated = append(updated, grant)
+	}
+	sort.Slice(updated, func(i, j int) bool { return updated[i].Grantee < updated[j].Grantee })
+
+	data, err := json.Marshal(updated)
+	if err != nil {
+		return err
+	}
+	historyPath := s.historyPath(userID, filename)
+	if err := os.MkdirAll(historyPath, 0755); err != nil {
+		return err
+	}
+	return writeFileAtomic(filepath.Join(historyPath, grantsName), data)
+}
+
+// RevokeGrantee rewrites every grants file that lists grantee. The history
+// of a file is a directory, so a regular file named grantsName below the
+// history root is always a grants file.
+func (s *FileStore) RevokeGrantee(ctx context.Context, grantee string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	root := filepath.Join(s.configDir, historyDirName)
+	err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() || entry.Name() != grantsName {
+			return nil
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		var grants []Grant
+		if err := json.Unmarshal(data, &grants); err != nil {
+			return err
+		}
+		kept := grants[:0]
+		for _, grant := range grants {
+			if grant.Grantee != grantee {
+				kept = append(kept, grant)
+			}
+		}
+		if len(kept) == len(grants) {
+			return nil
+		}
+		if data, err = json.Marshal(kept); err != nil {
+			return err
+		}
+		return writeFileAtomic(path, data)
+	})
+	if isNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// loadGrants reads the grants file of a file, which is absent for files
+// that were never shared
+func (s *FileStore) loadGrants(userID, filename string) ([]Grant, error) {
+	data, err := os.ReadFile(filepath.Join(s.historyPath(userID, filename), grantsName))
+	if err != nil {
+		if isNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var grants []Grant
+	if err := json.Unmarshal(data, &grants); err != nil {
+		return nil, err
+	}
+	return grants, nil
+}
+
+// schemasPath returns the file listing the schemas of a namespace
+func (s *FileStore) schemasPath(userID string) string {
+	return filepath.Join(s.configDir, schemasDirName, userID+".json")
+}
+
+// Schemas reads the schemas of a namespace
+func (s *FileStore) Schemas(ctx context.Context, userID string) ([]*Schema, error) {
+	data, err := os.ReadFile(s.schemasPath(userID))
+	if err != nil {
+		if isNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var schemas []*Schema
+	if err := json.Unmarshal(data, &schemas); err != nil {
+		return nil, err
+	}
+	return schemas, nil
+}
+
+// PutSchema rewrites the schemas file of a namespace with schema added or
+// replaced
+func (s *FileStore) PutSchema(ctx context.Context, userID string, schema *Schema) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	schemas, err := s.Schemas(ctx, userID)
+	if err != nil {
+		return err
+	}
+	updated := []*Schema{schema}
+	for _, existing := range schemas {
+		if existing.Pattern != schema.Pattern {
+			updated = append(updated, existing)
+		}
+	}
+	sort.Slice(updated, func(i, j int) bool { return updated[i].Pattern < updated[j].Pattern })
+	return s.saveSchemas(userID, updated)
+}
+
+// DeleteSchema rewrites the schemas file of a namespace without the schema
+// registered for pattern
+func (s *FileStore) DeleteSchema(ctx context.Context, userID, pattern string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	schemas, err := s.Schemas(ctx, userID)
+	if err != nil {
+		return err
+	}
+	updated := make([]*Schema, 0, len(schemas))
+	for _, existing := range schemas {
+		if existing.Pattern != pattern {
+			updated = append(updated, existing)
+		}
+	}
+	if len(updated) == len(schemas) {
+		return ErrSchemaNotFound
+	}
+	if len(updated) == 0 {
+		return os.Remove(s.schemasPath(userID))
+	}
+	return s.saveSchemas(userID, updated)
+}
+
+func (s *FileStore) saveSchemas(userID string, schemas []*Schema) error {
+	data, err := json.Marshal(schemas)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(s.schemasPath(userID)), 0755); err != nil {
+		return err
+	}
+	return writeFileAtomic(s.schemasPath(userID), data)
+}
+
+// isNotExist reports whether err means a path does not exist, including
+// paths that run through a file where a directory is expected
+func isNotExist(err error) bool {
+	return errors.Is(err, fs.ErrNotExist) || errors.Is(err, syscall.ENOTDIR)
+}
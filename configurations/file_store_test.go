@@ -0,0 +1,234 @@
+package configurations
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeOldIndex stores a file with a revision index in the format written
+// before file types had names, holding fileType as a number
+func writeOldIndex(t *testing.T, s *FileStore, userID, filename string, fileType int) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(s.path(userID, filename)), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(s.path(userID, filename), []byte("a: 1\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(s.historyPath(userID, filename), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(s.revisionPath(userID, filename, 1), []byte("a: 1\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	index := fmt.Sprintf(`{"revisions": [{"revision": 1, "author": %q, "hash": %q, "size": 5, "file_type": %d, "created_at": "2024-01-02T03:04:05Z"}]}`,
+		userID, contentHash([]byte("a: 1\n")), fileType)
+	if err := os.WriteFile(filepath.Join(s.historyPath(userID, filename), revisionIndexName), []byte(index), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFileStoreReadsOldIndexes(t *testing.T) {
+	tests := []struct {
+		filename string
+		fileType int
+		want     FileType
+	}{
+		// 0 was never written; the type comes from the name
+		{"app.yaml", 0, FileTypeYAML},
+		{"notes", 0, FileTypeTXT},
+		{"app.yaml", 1, FileTypeTXT},
+		{"app.yaml", 2, FileTypeCSV},
+		{"app.yaml", 3, FileTypeJSON},
+		{"app.yaml", 4, FileTypeXML},
+		{"app.json", 5, FileTypeYAML},
+	}
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%s stored as %d", test.filename, test.fileType), func(t *testing.T) {
+			ctx := context.Background()
+			s := NewFileStore(t.TempDir())
+			writeOldIndex(t, s, "u", test.filename, test.fileType)
+
+			revisions, err := s.Revisions(ctx, "u", test.filename)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(revisions) != 1 || revisions[0].Number != 1 || revisions[0].Author != "u" || revisions[0].FileType != test.want {
+				t.Fatalf("revisions = %+v, want revision 1 of type %v", revisions, test.want)
+			}
+			if _, file, err := s.Get(ctx, "u", test.filename); err != nil || file.FileType != test.want {
+				t.Fatalf("Get = %+v, %v; want type %v", file, err, test.want)
+			}
+
+			// The next write stores the index with names
+			err = s.Put(ctx, &ConfigFile{UserID: "u", Filename: test.filename, FileType: test.want}, &Revision{Author: "u"}, []byte("a: 2\n"), Precondition{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			index, err := os.ReadFile(filepath.Join(s.historyPath("u", test.filename), revisionIndexName))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if name := fmt.Sprintf(`"file_type":"%s"`, test.want); strings.Count(string(index), name) != 2 {
+				t.Errorf("index %s does not name the type %s of both revisions", index, test.want)
+			}
+		})
+	}
+}
+
+// putFile writes data as the next revision of a file of user u
+func putFile(s *FileStore, filename, data string) (*Revision, error) {
+	rev := &Revision{Author: "u"}
+	file := &ConfigFile{UserID: "u", Filename: filename, FileType: FileTypeJSON}
+	return rev, s.Put(context.Background(), file, rev, []byte(data), Precondition{})
+}
+
+// stagedFiles returns the temporary files left below dir
+func stagedFiles(t *testing.T, dir string) []string {
+	t.Helper()
+	var staged []string
+	err := filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+		if err == nil && isStagedFile(d.Name()) {
+			staged = append(staged, path)
+		}
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return staged
+}
+
+func TestFileStorePut(t *testing.T) {
+	ctx := context.Background()
+	s := NewFileStore(t.TempDir())
+	contents := []string{`{"v": 1}`, `{"v": 2}`, ""}
+	for i, content := range contents {
+		rev, err := putFile(s, "dir/app.json", content)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rev.Number != i+1 || rev.Hash != contentHash([]byte(content)) || rev.Size != int64(len(content)) ||
+			rev.FileType != FileTypeJSON || rev.Author != "u" || rev.CreatedAt.IsZero() {
+			t.Errorf("revision %d = %+v", i+1, rev)
+		}
+	}
+
+	data, file, err := s.Get(ctx, "u", "dir/app.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "" || file.Revision != 3 || file.Hash != contentHash(nil) {
+		t.Errorf("Get = %q, %+v; want the empty revision 3", data, file)
+	}
+	for i, content := range contents {
+		data, rev, err := s.GetRevision(ctx, "u", "dir/app.json", i+1)
+		if err != nil || string(data) != content || rev.Number != i+1 {
+			t.Errorf("GetRevision(%d) = %q, %+v, %v; want %q", i+1, data, rev, err, content)
+		}
+	}
+	if revisions, err := s.Revisions(ctx, "u", "dir/app.json"); err != nil || len(revisions) != 3 {
+		t.Errorf("Revisions = %d revisions, %v; want 3", len(revisions), err)
+	}
+	if staged := stagedFiles(t, s.configDir); len(staged) > 0 {
+		t.Errorf("temporary files left behind: %v", staged)
+	}
+}
+
+func TestFileStoreFailedPut(t *testing.T) {
+	ctx := context.Background()
+	s := NewFileStore(t.TempDir())
+	if _, err := putFile(s, "app.json", `{"v": 1}`); err != nil {
+		t.Fatal(err)
+	}
+	indexPath := filepath.Join(s.historyPath("u", "app.json"), revisionIndexName)
+	index, err := os.ReadFile(indexPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A non-empty directory where revision 2 goes makes moving it into
+	// place fail after every file was staged
+	blocker := filepath.Join(s.revisionPath("u", "app.json", 2), "blocker")
+	if err := os.MkdirAll(blocker, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := putFile(s, "app.json", `{"v": 2}`); err == nil {
+		t.Fatal("Put succeeded over a directory")
+	}
+
+	data, file, err := s.Get(ctx, "u", "app.json")
+	if err != nil || string(data) != `{"v": 1}` || file.Revision != 1 {
+		t.Errorf("Get = %q, %+v, %v; want revision 1", data, file, err)
+	}
+	if current, err := os.ReadFile(s.path("u", "app.json")); err != nil || string(current) != `{"v": 1}` {
+		t.Errorf("current file = %q, %v; want the old content", current, err)
+	}
+	if after, err := os.ReadFile(indexPath); err != nil || string(after) != string(index) {
+		t.Errorf("index changed to %s, %v", after, err)
+	}
+	if staged := stagedFiles(t, s.configDir); len(staged) > 0 {
+		t.Errorf("temporary files left behind: %v", staged)
+	}
+
+	// Once the obstacle is gone the revision number is free again
+	if err := os.RemoveAll(s.revisionPath("u", "app.json", 2)); err != nil {
+		t.Fatal(err)
+	}
+	if rev, err := putFile(s, "app.json", `{"v": 2}`); err != nil || rev.Number != 2 {
+		t.Fatalf("Put = %+v, %v; want revision 2", rev, err)
+	}
+}
+
+func TestFileStorePutOverOrphanRevision(t *testing.T) {
+	ctx := context.Background()
+	s := NewFileStore(t.TempDir())
+	if _, err := putFile(s, "app.json", `{"v": 1}`); err != nil {
+		t.Fatal(err)
+	}
+	// A write interrupted after moving its revision into place leaves a
+	// revision that the index does not list
+	if err := os.WriteFile(s.revisionPath("u", "app.json", 2), []byte("orphan"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, file, err := s.Get(ctx, "u", "app.json"); err != nil || file.Revision != 1 {
+		t.Fatalf("Get = %+v, %v; want revision 1", file, err)
+	}
+
+	if _, err := putFile(s, "app.json", `{"v": 2}`); err != nil {
+		t.Fatal(err)
+	}
+	if data, _, err := s.GetRevision(ctx, "u", "app.json", 2); err != nil || string(data) != `{"v": 2}` {
+		t.Errorf("GetRevision(2) = %q, %v; want the new content", data, err)
+	}
+}
+
+func TestFileStoreRenameRollback(t *testing.T) {
+	ctx := context.Background()
+	s := NewFileStore(t.TempDir())
+	for _, filename := range []string{"app.json", "x"} {
+		if _, err := putFile(s, filename, `{"v": 1}`); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The history moves below x, but the file cannot, since x is a file
+	if err := s.Rename(ctx, "u", "app.json", "x/app.json", Precondition{}); err == nil {
+		t.Fatal("Rename succeeded below a file")
+	}
+
+	data, file, err := s.Get(ctx, "u", "app.json")
+	if err != nil || string(data) != `{"v": 1}` || file.Revision != 1 {
+		t.Errorf("Get = %q, %+v, %v; want revision 1", data, file, err)
+	}
+	if revisions, err := s.Revisions(ctx, "u", "app.json"); err != nil || len(revisions) != 1 {
+		t.Errorf("Revisions = %d revisions, %v; want 1", len(revisions), err)
+	}
+	if _, err := os.Stat(s.historyPath("u", "x/app.json")); !isNotExist(err) {
+		t.Errorf("history left at the new name: %v", err)
+	}
+}
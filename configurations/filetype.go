@@ -0,0 +1,321 @@
+package configurations
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// FileType is the format of a configuration file. The numeric values are
+// the ones stored with every revision.
+type FileType int
+
+const (
+	// FileTypeUnspecified lets the server derive the type from the file name
+	// or its content
+	FileTypeUnspecified FileType = iota
+	FileTypeTXT
+	FileTypeCSV
+	FileTypeJSON
+	FileTypeXML
+	FileTypeYAML
+	FileTypeTOML
+	FileTypeINI
+	// FileTypeProperties is the Java properties format
+	FileTypeProperties
+	// FileTypeHCL is the HashiCorp configuration language of Terraform
+	FileTypeHCL
+	// FileTypeEnv is the .env format of KEY=value lines
+	FileTypeEnv
+)
+
+var (
+	// ErrInvalidFileType is returned for unknown file types
+	ErrInvalidFileType = errors.New("invalid file type")
+	// ErrFileTypeMismatch is returned when content cannot be parsed as the
+	// type of its file, wrapped in a ValidationError
+	ErrFileTypeMismatch = errors.New("content does not match the file type")
+)
+
+// fileTypeNames are the names of the file types in JSON and on the command
+// line
+var fileTypeNames = map[FileType]string{
+	FileTypeTXT:        "txt",
+	FileTypeCSV:        "csv",
+	FileTypeJSON:       "json",
+	FileTypeXML:        "xml",
+	FileTypeYAML:       "yaml",
+	FileTypeTOML:       "toml",
+	FileTypeINI:        "ini",
+	FileTypeProperties: "properties",
+	FileTypeHCL:        "hcl",
+	FileTypeEnv:        "env",
+}
+
+// fileTypeExtensions maps file name extensions to the type they imply
+var fileTypeExtensions = map[string]FileType{
+	".txt":        FileTypeTXT,
+	".csv":        FileTypeCSV,
+	".json":       FileTypeJSON,
+	".xml":        FileTypeXML,
+	".yaml":       FileTypeYAML,
+	".yml":        FileTypeYAML,
+	".toml":       FileTypeTOML,
+	".ini":        FileTypeINI,
+	".properties": FileTypeProperties,
+	".hcl":        FileTypeHCL,
+	".tf":         FileTypeHCL,
+	".tfvars":     FileTypeHCL,
+	// filepath.Ext also returns ".env" for a file named .env
+	".env": FileTypeEnv,
+}
+
+// ParseFileType converts a file type name into a FileType. The empty name
+// yields FileTypeUnspecified.
+func ParseFileType(name string) (FileType, error) {
+	name = strings.ToLower(name)
+	if name == "" {
+		return FileTypeUnspecified, nil
+	}
+	if name == "yml" {
+		return FileTypeYAML, nil
+	}
+	for t, typeName := range fileTypeNames {
+		if typeName == name {
+			return t, nil
+		}
+	}
+	return FileTypeUnspecified, fmt.Errorf("%w: %q", ErrInvalidFileType, name)
+}
+
+// Valid reports whether t is a known file type
+func (t FileType) Valid() bool {
+	_, ok := fileTypeNames[t]
+	return ok
+}
+
+// structured reports whether files of type t hold keys and values, which
+// can be converted, diffed by key path and validated against schemas
+func (t FileType) structured() bool {
+	switch t {
+	case FileTypeJSON, FileTypeYAML, FileTypeTOML, FileTypeINI, FileTypeProperties, FileTypeHCL, FileTypeEnv:
+		return true
+	}
+	return false
+}
+
+func (t FileType) String() string {
+	if name, ok := fileTypeNames[t]; ok {
+		return name
+	}
+	if t == FileTypeUnspecified {
+		return ""
+	}
+	return fmt.Sprintf("FileType(%d)", int(t))
+}
+
+// MarshalJSON encodes the file type by name
+func (t FileType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+// UnmarshalJSON accepts a file type name, or the numeric value sent by
+// clients written before file types had names. Those clients numbered the
+// types like the protobuf enum of the time, from TXT at 0 to YAML at 4.
+func (t *FileType) UnmarshalJSON(data []byte) error {
+	// null decodes into an int as 0, which would read as txt
+	if string(data) == "null" {
+		return nil
+	}
+
+	var number int
+	if err := json.Unmarshal(data, &number); err == nil {
+		if parsed := legacyFileType(number); parsed.Valid() {
+			*t = parsed
+			return nil
+		}
+		return fmt.Errorf("%w: %d", ErrInvalidFileType, number)
+	}
+
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidFileType, data)
+	}
+	parsed, err := ParseFileType(name)
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
+
+// FileTypeFromExtension returns the type implied by the extension of a
+// file name, or FileTypeUnspecified for unknown extensions
+func FileTypeFromExtension(filename string) FileType {
+	return fileTypeExtensions[strings.ToLower(filepath.Ext(filename))]
+}
+
+// DetectFileType derives the type of a file from its name, or from its
+// content if the extension is unknown
+func DetectFileType(filename string, data []byte) FileType {
+	if t := FileTypeFromExtension(filename); t != FileTypeUnspecified {
+		return t
+	}
+	return SniffFileType(data)
+}
+
+// SniffFileType guesses the type of content. Content that looks like none
+// of the structured types is text. Since the key/value formats overlap, the
+// stricter ones are tried first: a file of NAME=value lines is TOML if its
+// values are quoted or numbers, .env if its names are variable names and
+// properties otherwise.
+func SniffFileType(data []byte) FileType {
+	trimmed := bytes.TrimSpace(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf")))
+	switch {
+	case len(trimmed) == 0:
+		return FileTypeTXT
+	case (trimmed[0] == '{' || trimmed[0] == '[') && json.Valid(trimmed):
+		return FileTypeJSON
+	case trimmed[0] == '<' && validateXML(trimmed) == nil:
+		return FileTypeXML
+	case looksLikeTOML(trimmed):
+		return FileTypeTOML
+	case looksLikeHCL(trimmed):
+		return FileTypeHCL
+	case looksLikeINI(trimmed):
+		return FileTypeINI
+	case looksLikeCSV(trimmed):
+		return FileTypeCSV
+	case looksLikeYAML(trimmed):
+		return FileTypeYAML
+	case looksLikeEnv(trimmed):
+		return FileTypeEnv
+	case looksLikeProperties(trimmed):
+		return FileTypeProperties
+	}
+	return FileTypeTXT
+}
+
+// looksLikeCSV reports whether data has several records of the same
+// number of fields
+func looksLikeCSV(data []byte) bool {
+	records, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+	return err == nil && len(records) > 1 && len(records[0]) > 1
+}
+
+// looksLikeYAML reports whether data is a YAML mapping or sequence; plain
+// text parses as a single YAML string
+func looksLikeYAML(data []byte) bool {
+	var doc interface{}
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return false
+	}
+	switch doc.(type) {
+	case map[interface{}]interface{}, []interface{}:
+		return true
+	}
+	return false
+}
+
+// looksLikeTOML reports whether data is a TOML document with at least one
+// key
+func looksLikeTOML(data []byte) bool {
+	doc, err := decodeTOML(data)
+	return err == nil && len(doc) > 0
+}
+
+// looksLikeHCL reports whether data is HCL with at least one block;
+// plain attributes are more likely TOML or .env
+func looksLikeHCL(data []byte) bool {
+	body, err := parseHCL(data)
+	return err == nil && len(body.Blocks) > 0
+}
+
+// looksLikeINI reports whether data is an INI file with at least one
+// section
+func looksLikeINI(data []byte) bool {
+	doc, err := decodeINI(data)
+	if err != nil {
+		return false
+	}
+	for _, value := range doc {
+		if _, ok := value.(map[string]interface{}); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// looksLikeEnv reports whether data is a .env file
+func looksLikeEnv(data []byte) bool {
+	doc, err := decodeEnv(data)
+	return err == nil && len(doc) > 0
+}
+
+// looksLikeProperties reports whether every entry of data separates its key
+// and value with "=" or ":"; any text parses as properties with whitespace
+// separators
+func looksLikeProperties(data []byte) bool {
+	if _, err := decodeProperties(data); err != nil {
+		return false
+	}
+	entries := 0
+	continued := false
+	for _, line := range splitLines(data) {
+		line = strings.TrimLeft(line, " \t\f")
+		wasContinued := continued
+		continued = continues(line)
+		if wasContinued || line == "" || line[0] == '#' || line[0] == '!' {
+			continue
+		}
+		if !strings.ContainsAny(line, "=:") {
+			return false
+		}
+		entries++
+	}
+	return entries > 0
+}
+
+// resolveFileType determines the type of new content and checks that the
+// content matches it. An unspecified type is derived from the file name or
+// the content.
+func resolveFileType(fileType FileType, filename string, data []byte) (FileType, error) {
+	if fileType == FileTypeUnspecified {
+		fileType = DetectFileType(filename, data)
+	}
+	if !fileType.Valid() {
+		return FileTypeUnspecified, fmt.Errorf("%w: %d", ErrInvalidFileType, int(fileType))
+	}
+	if err := fileType.Validate(data); err != nil {
+		return FileTypeUnspecified, err
+	}
+	return fileType, nil
+}
+
+// storedFileType returns the type of a stored file, deriving it from the
+// file name for revisions stored without a valid type
+func storedFileType(fileType FileType, filename string) FileType {
+	if fileType.Valid() {
+		return fileType
+	}
+	if fileType = FileTypeFromExtension(filename); fileType.Valid() {
+		return fileType
+	}
+	return FileTypeTXT
+}
+
+// legacyFileType converts a file type stored as the value of the protobuf
+// enum before FILE_TYPE_UNSPECIFIED was added, which numbered the types
+// from TXT at 0 to YAML at 4
+func legacyFileType(number int) FileType {
+	if number < 0 || number > 4 {
+		return FileTypeUnspecified
+	}
+	return FileType(number + 1)
+}
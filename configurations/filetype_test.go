@@ -0,0 +1,215 @@
+package configurations
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestParseFileType(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    FileType
+		wantErr bool
+	}{
+		{"", FileTypeUnspecified, false},
+		{"json", FileTypeJSON, false},
+		{"YAML", FileTypeYAML, false},
+		{"yml", FileTypeYAML, false},
+		{"Txt", FileTypeTXT, false},
+		{"properties", FileTypeProperties, false},
+		{"env", FileTypeEnv, false},
+		{"docx", FileTypeUnspecified, true},
+		{".json", FileTypeUnspecified, true},
+	}
+	for _, test := range tests {
+		got, err := ParseFileType(test.name)
+		if got != test.want || (err != nil) != test.wantErr {
+			t.Errorf("ParseFileType(%q) = %v, %v; want %v, error %v", test.name, got, err, test.want, test.wantErr)
+		}
+		if err != nil && !errors.Is(err, ErrInvalidFileType) {
+			t.Errorf("ParseFileType(%q) error %v does not wrap ErrInvalidFileType", test.name, err)
+		}
+	}
+}
+
+func TestFileTypeJSON(t *testing.T) {
+	tests := []struct {
+		fileType FileType
+		encoded  string
+	}{
+		{FileTypeUnspecified, `""`},
+		{FileTypeTXT, `"txt"`},
+		{FileTypeCSV, `"csv"`},
+		{FileTypeJSON, `"json"`},
+		{FileTypeXML, `"xml"`},
+		{FileTypeYAML, `"yaml"`},
+		{FileTypeTOML, `"toml"`},
+		{FileTypeINI, `"ini"`},
+		{FileTypeProperties, `"properties"`},
+		{FileTypeHCL, `"hcl"`},
+		{FileTypeEnv, `"env"`},
+	}
+	for _, test := range tests {
+		encoded, err := json.Marshal(test.fileType)
+		if err != nil || string(encoded) != test.encoded {
+			t.Errorf("marshal %d = %s, %v; want %s", int(test.fileType), encoded, err, test.encoded)
+		}
+		var decoded FileType
+		if err := json.Unmarshal(encoded, &decoded); err != nil || decoded != test.fileType {
+			t.Errorf("unmarshal %s = %v, %v; want %v", encoded, decoded, err, test.fileType)
+		}
+	}
+
+	if encoded, _ := json.Marshal(FileType(99)); string(encoded) != `"FileType(99)"` {
+		t.Errorf("marshal 99 = %s", encoded)
+	}
+}
+
+func TestFileTypeUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		data    string
+		want    FileType
+		wantErr bool
+	}{
+		{`"yml"`, FileTypeYAML, false},
+		{`"toml"`, FileTypeTOML, false},
+		{`""`, FileTypeUnspecified, false},
+		// Numbers are the protobuf enum values from before
+		// FILE_TYPE_UNSPECIFIED
+		{`0`, FileTypeTXT, false},
+		{`1`, FileTypeCSV, false},
+		{`2`, FileTypeJSON, false},
+		{`3`, FileTypeXML, false},
+		{`4`, FileTypeYAML, false},
+		{`5`, FileTypeUnspecified, true},
+		{`10`, FileTypeUnspecified, true},
+		{`-1`, FileTypeUnspecified, true},
+		{`"docx"`, FileTypeUnspecified, true},
+		{`true`, FileTypeUnspecified, true},
+		{`null`, FileTypeUnspecified, false},
+	}
+	for _, test := range tests {
+		var got FileType
+		err := json.Unmarshal([]byte(test.data), &got)
+		if got != test.want || (err != nil) != test.wantErr {
+			t.Errorf("unmarshal %s = %v, %v; want %v, error %v", test.data, got, err, test.want, test.wantErr)
+		}
+		if err != nil && !errors.Is(err, ErrInvalidFileType) {
+			t.Errorf("unmarshal %s error %v does not wrap ErrInvalidFileType", test.data, err)
+		}
+	}
+}
+
+func TestSniffFileType(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want FileType
+	}{
+		{"empty", "", FileTypeTXT},
+		{"whitespace", " \n\t\n", FileTypeTXT},
+		{"plain text", "hello world\n", FileTypeTXT},
+		{"JSON object", `{"a": 1}`, FileTypeJSON},
+		{"JSON array", "[1, 2]\n", FileTypeJSON},
+		{"JSON with a byte order mark", "\xef\xbb\xbf{\"a\": 1}", FileTypeJSON},
+		{"invalid JSON", "{not json", FileTypeTXT},
+		{"XML", "<config><a>1</a></config>", FileTypeXML},
+		{"invalid XML", "<not xml", FileTypeTXT},
+		{"TOML", "name = \"app\"\nport = 8080\n", FileTypeTOML},
+		{"TOML before .env", "PORT=8080\n", FileTypeTOML},
+		{"TOML before INI", "[server]\nport = 8080\n", FileTypeTOML},
+		{"INI with unquoted values", "[server]\nhost = example.com\n", FileTypeINI},
+		{"HCL blocks", "resource \"a\" \"b\" {\n  size = 1\n}\n", FileTypeHCL},
+		{"HCL attributes only", "size = var.size\n", FileTypeEnv},
+		{"CSV", "a,b,c\n1,2,3\n", FileTypeCSV},
+		{"single CSV record", "a,b,c\n", FileTypeTXT},
+		{"YAML mapping", "name: app\nitems:\n  - a\n", FileTypeYAML},
+		{"YAML sequence", "- a\n- b\n", FileTypeYAML},
+		{".env", "NAME=value\nexport OTHER='x y'\n", FileTypeEnv},
+		{"properties", "db.host=localhost\ndb.port=5432\n", FileTypeProperties},
+		{"properties with continuations", "app.list=a,\\\n  b\napp.name=x\n", FileTypeProperties},
+		{"text lines with a separator", "db.host=localhost\nnot a property\n", FileTypeTXT},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := SniffFileType([]byte(test.data)); got != test.want {
+				t.Errorf("SniffFileType(%q) = %v, want %v", test.data, got, test.want)
+			}
+		})
+	}
+}
+
+func TestDetectFileType(t *testing.T) {
+	tests := []struct {
+		filename string
+		data     string
+		want     FileType
+	}{
+		// The extension wins over the content
+		{"app.json", "a: 1", FileTypeJSON},
+		{"APP.YML", "{}", FileTypeYAML},
+		{"dir.json/app.txt", "{}", FileTypeTXT},
+		{"app.toml", "", FileTypeTOML},
+		{"app.ini", "", FileTypeINI},
+		{"app.properties", "", FileTypeProperties},
+		{"main.tf", "", FileTypeHCL},
+		{"prod.tfvars", "", FileTypeHCL},
+		{".env", "{}", FileTypeEnv},
+		// Unknown extensions are sniffed
+		{"app.conf", `{"a": 1}`, FileTypeJSON},
+		{"README", "just some words", FileTypeTXT},
+	}
+	for _, test := range tests {
+		if got := DetectFileType(test.filename, []byte(test.data)); got != test.want {
+			t.Errorf("DetectFileType(%q, %q) = %v, want %v", test.filename, test.data, got, test.want)
+		}
+	}
+}
+
+func TestResolveFileType(t *testing.T) {
+	tests := []struct {
+		name     string
+		fileType FileType
+		filename string
+		data     string
+		want     FileType
+		wantErr  error
+	}{
+		{name: "derived from the name", filename: "app.yaml", data: "a: 1", want: FileTypeYAML},
+		{name: "derived from the content", filename: "app", data: `{"a": 1}`, want: FileTypeJSON},
+		{name: "explicit type wins over the name", fileType: FileTypeTXT, filename: "app.json", data: "{", want: FileTypeTXT},
+		{name: "content must match the name", filename: "app.json", data: "{", wantErr: ErrFileTypeMismatch},
+		{name: "content must match the explicit type", fileType: FileTypeXML, filename: "app", data: "<a>", wantErr: ErrFileTypeMismatch},
+		{name: "derived from a tfvars name", filename: "prod.tfvars", data: "size = 1\n", want: FileTypeHCL},
+		{name: "content must match hcl", fileType: FileTypeHCL, filename: "app", data: "block {\n", wantErr: ErrFileTypeMismatch},
+		{name: "empty content matches every type", fileType: FileTypeCSV, filename: "app", data: "", want: FileTypeCSV},
+		{name: "unknown type", fileType: FileType(42), filename: "app.json", data: "{}", wantErr: ErrInvalidFileType},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := resolveFileType(test.fileType, test.filename, []byte(test.data))
+			if !errors.Is(err, test.wantErr) || got != test.want {
+				t.Errorf("got %v, %v; want %v, %v", got, err, test.want, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestStoredFileType(t *testing.T) {
+	tests := []struct {
+		fileType FileType
+		filename string
+		want     FileType
+	}{
+		{FileTypeYAML, "app.json", FileTypeYAML},
+		{FileTypeUnspecified, "app.json", FileTypeJSON},
+		{FileType(99), "app.toml", FileTypeTOML},
+		{FileTypeUnspecified, "README", FileTypeTXT},
+	}
+	for _, test := range tests {
+		if got := storedFileType(test.fileType, test.filename); got != test.want {
+			t.Errorf("storedFileType(%v, %q) = %v, want %v", test.fileType, test.filename, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package configurations
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"k8s.io/klog/v2"
+)
+
+// changeStreamRetryInterval is how long Notify waits before resuming a
+// failed change stream
+const changeStreamRetryInterval = 5 * time.Second
+
+// changeStreamHistoryLost is the server error returned when a change stream
+// cannot be resumed because the oplog no longer holds its position
+const changeStreamHistoryLost = 286
+
+// changeField is the path of the gridFSChange stamp in fs.files documents
+const changeField = "metadata.change"
+
+// gridFSChange is stamped on the latest revision of a file that is renamed
+// or about to be deleted. Change streams only carry the changed fields of
+// updated documents and nothing but the ID of deleted ones, so the stamp
+// tells other replicas which file changed.
+type gridFSChange struct {
+	UserID string `bson:"userID"`
+	From   string `bson:"from"`
+	// To is the new filename of renamed files and empty for deletions
+	To string `bson:"to,omitempty"`
+	// At makes every stamp differ from the previous one, so it always
+	// shows up as an updated field
+	At time.Time `bson:"at"`
+}
+
+// gridFSChangeEvent is a change stream event of the fs.files collection
+type gridFSChangeEvent struct {
+	OperationType     string      `bson:"operationType"`
+	FullDocument      *gridFSFile `bson:"fullDocument"`
+	UpdateDescription struct {
+		UpdatedFields bson.Raw `bson:"updatedFields"`
+	} `bson:"updateDescription"`
+}
+
+// stampChange records a rename or deletion on a revision of a file
+func (s *GridFSStore) stampChange(ctx context.Context, fileID primitive.ObjectID, change gridFSChange) error {
+	change.At = time.Now()
+	update := bson.M{"$set": bson.M{changeField: change}}
+	_, err := s.db.Collection("fs.files").UpdateOne(ctx, bson.M{"_id": fileID}, update)
+	return err
+}
+
+// Notify follows a change stream of the fs.files collection, which reports
+// the revisions uploaded and the stamps written by every replica. It
+// requires MongoDB to run as a replica set. A failed stream is resumed where
+// it stopped until its position is no longer available.
+func (s *GridFSStore) Notify(ctx context.Context, ready func(), publish func(*Event)) error {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"operationType": bson.M{"$in": bson.A{"insert", "update"}}}}},
+	}
+
+	var resumeToken bson.Raw
+	for {
+		opts := options.ChangeStream().SetFullDocument(options.UpdateLookup)
+		if resumeToken != nil {
+			opts.SetResumeAfter(resumeToken)
+		}
+
+		stream, err := s.db.Collection("fs.files").Watch(ctx, pipeline, opts)
+		if err == nil {
+			if resumeToken == nil {
+				ready()
+			}
+			err = s.follow(ctx, stream, publish)
+			if token := stream.ResumeToken(); token != nil {
+				resumeToken = token
+			}
+			stream.Close(context.Background())
+		}
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		var serverErr mongo.ServerError
+		if resumeToken == nil || (errors.As(err, &serverErr) && serverErr.HasErrorCode(changeStreamHistoryLost)) {
+			return err
+		}
+
+		klog.FromContext(ctx).Error(err, "change stream failed, resuming", "retryInterval", changeStreamRetryInterval)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(changeStreamRetryInterval):
+		}
+	}
+}
+
+// follow publishes the events of a change stream until it fails
+func (s *GridFSStore) follow(ctx context.Context, stream *mongo.ChangeStream, publish func(*Event)) error {
+	for stream.Next(ctx) {
+		var event gridFSChangeEvent
+		if err := stream.Decode(&event); err != nil {
+			return err
+		}
+		event.publish(publish)
+	}
+	return stream.Err()
+}
+
+// publish converts a change stream event into the events of the change bus.
+// Inserted documents are new revisions; updates only matter when they
+// stamped a change.
+func (e *gridFSChangeEvent) publish(publish func(*Event)) {
+	file := e.FullDocument
+	if e.OperationType == "insert" {
+		if file != nil {
+			publish(&Event{
+				Type:      EventUpdated,
+				Namespace: file.Metadata.UserID,
+				Filename:  file.Filename,
+				Revision:  file.revision(),
+			})
+		}
+		return
+	}
+
+	change, ok := e.change()
+	if !ok {
+		return
+	}
+	publish(&Event{
+		Type:      EventDeleted,
+		Namespace: change.UserID,
+		Filename:  change.From,
+	})
+
+	// The document is looked up when the event is read, so a file that
+	// was renamed or deleted again since is reported by a later event
+	if change.To != "" && file != nil && file.Filename == change.To {
+		publish(&Event{
+			Type:      EventUpdated,
+			Namespace: change.UserID,
+			Filename:  change.To,
+			Revision:  file.revision(),
+		})
+	}
+}
+
+// change returns the stamp written by an update, which the server reports
+// either as a whole or, when it diffed it against the previous stamp, field
+// by field
+func (e *gridFSChangeEvent) change() (*gridFSChange, bool) {
+	fields := e.UpdateDescription.UpdatedFields
+	if value, err := fields.LookupErr(changeField); err == nil {
+		var change gridFSChange
+		if err := value.Unmarshal(&change); err != nil {
+			return nil, false
+		}
+		return &change, true
+	}
+
+	elements, err := fields.Elements()
+	if err != nil {
+		return nil, false
+	}
+	for _, element := range elements {
+		if strings.HasPrefix(element.Key(), changeField+".") && e.FullDocument != nil && e.FullDocument.Metadata.Change != nil {
+			return e.FullDocument.Metadata.Change, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,661 @@
+package configurations
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/gridfs"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// GridFSStore stores configuration files in MongoDB GridFS. Every revision
+// of a file is uploaded as a separate GridFS file carrying its revision
+// number in the metadata.
+type GridFSStore struct {
+	db *mongo.Database
+
+	indexMu sync.Mutex
+	indexed bool
+}
+
+// NewGridFSStore creates a new GridFS backed store
+func NewGridFSStore(db *mongo.Database) *GridFSStore {
+	return &GridFSStore{db: db}
+}
+
+// gridFSFileTypeVersion marks uploads whose file type is a FileType value.
+// Uploads without it hold the value of the protobuf enum, see
+// legacyFileType.
+const gridFSFileTypeVersion = 1
+
+// gridFSFile mirrors a document of the fs.files collection
+type gridFSFile struct {
+	ID         primitive.ObjectID `bson:"_id"`
+	Filename   string             `bson:"filename"`
+	Length     int64              `bson:"length"`
+	UploadDate time.Time          `bson:"uploadDate"`
+	Metadata   struct {
+		UserID   string   `bson:"userID"`
+		FileType FileType `bson:"fileType"`
+		// FileTypeVersion is gridFSFileTypeVersion for current uploads
+		FileTypeVersion int       `bson:"fileTypeVersion"`
+		Created         time.Time `bson:"created"`
+		Updated         time.Time `bson:"updated"`
+		Revision        int       `bson:"revision"`
+		Author          string    `bson:"author"`
+		Hash            string    `bson:"hash"`
+		RollbackOf      int       `bson:"rollbackOf"`
+		// Change is the latest rename or deletion of the file
+		Change *gridFSChange `bson:"change,omitempty"`
+	} `bson:"metadata"`
+}
+
+// revisionNumber returns the revision of the upload. Files uploaded before
+// revisions were tracked count as the first revision.
+func (f *gridFSFile) revisionNumber() int {
+	if f.Metadata.Revision == 0 {
+		return 1
+	}
+	return f.Metadata.Revision
+}
+
+// fileType returns the type of the upload, deriving it from the file name
+// when none was stored
+func (f *gridFSFile) fileType() FileType {
+	fileType := f.Metadata.FileType
+	if f.Metadata.FileTypeVersion < gridFSFileTypeVersion {
+		fileType = legacyFileType(int(fileType))
+	}
+	return storedFileType(fileType, f.Filename)
+}
+
+func (f *gridFSFile) configFile() *ConfigFile {
+	return &ConfigFile{
+		ID:        f.ID.Hex(),
+		UserID:    f.Metadata.UserID,
+		Filename:  f.Filename,
+		FileType:  f.fileType(),
+		Size:      f.Length,
+		Revision:  f.revisionNumber(),
+		Hash:      f.Metadata.Hash,
+		UpdatedBy: f.Metadata.Author,
+		CreatedAt: f.Metadata.Created,
+		UpdatedAt: f.Metadata.Updated,
+	}
+}
+
+func (f *gridFSFile) revision() *Revision {
+	return &Revision{
+		Number:     f.revisionNumber(),
+		Author:     f.Metadata.Author,
+		Hash:       f.Metadata.Hash,
+		Size:       f.Length,
+		FileType:   f.fileType(),
+		CreatedAt:  f.Metadata.Updated,
+		RollbackOf: f.Metadata.RollbackOf,
+	}
+}
+
+// maxPutAttempts bounds how often an unconditional write is retried when a
+// concurrent writer claimed the same revision number
+const maxPutAttempts = 3
+
+// Put uploads data to GridFS as the next revision of the file. A unique
+// index on the revision number makes concurrent writers based on the same
+// revision conflict, so only one of them succeeds.
+func (s *GridFSStore) Put(ctx context.Context, file *ConfigFile, rev *Revision, data []byte, cond Precondition) error {
+	if err := s.ensureIndexes(ctx); err != nil {
+		return err
+	}
+
+	for attempt := 1; ; attempt++ {
+		err := s.put(ctx, file, rev, data, cond)
+		if !mongo.IsDuplicateKeyError(err) {
+			return err
+		}
+
+		switch {
+		case cond.NotExists:
+			return ErrFileExists
+		case cond.Revision != 0 || len(cond.ETags) > 0:
+			return fmt.Errorf("%w: file was modified concurrently", ErrPreconditionFailed)
+		case attempt == maxPutAttempts:
+			return err
+		}
+	}
+}
+
+func (s *GridFSStore) put(ctx context.Context, file *ConfigFile, rev *Revision, data []byte, cond Precondition) error {
+	bucket, err := gridfs.NewBucket(s.db)
+	if err != nil {
+		return err
+	}
+
+	latest, err := s.find(ctx, file.UserID, file.Filename)
+	if err != nil && !errors.Is(err, ErrFileNotFound) {
+		return err
+	}
+	current, err := s.currentFile(latest)
+	if err != nil {
+		return err
+	}
+	if err := cond.check(current); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	rev.Number = 1
+	rev.Hash = contentHash(data)
+	rev.Size = int64(len(data))
+	rev.FileType = file.FileType
+	rev.CreatedAt = now
+	created := now
+	if latest != nil {
+		rev.Number = latest.revisionNumber() + 1
+		created = latest.Metadata.Created
+	}
+
+	// Create metadata for the file
+	metadata := bson.M{
+		"userID":          file.UserID,
+		"fileType":        rev.FileType,
+		"fileTypeVersion": gridFSFileTypeVersion,
+		"created":         created,
+		"updated":         now,
+		"revision":        rev.Number,
+		"author":          rev.Author,
+		"hash":            rev.Hash,
+	}
+	if rev.RollbackOf != 0 {
+		metadata["rollbackOf"] = rev.RollbackOf
+	}
+
+	uploadOpts := options.GridFSUpload().SetMetadata(metadata)
+	uploadStream, err := bucket.OpenUploadStream(file.Filename, uploadOpts)
+	if err != nil {
+		return err
+	}
+
+	// The chunks are uploaded first and the revision only becomes visible
+	// once closing the stream inserts its file document, so a failed upload
+	// leaves the previous revision as the latest one
+	if _, err := uploadStream.Write(data); err != nil {
+		if abortErr := uploadStream.Abort(); abortErr != nil {
+			return errors.Join(err, abortErr)
+		}
+		return err
+	}
+	if err := uploadStream.Close(); err != nil {
+		// The file document was rejected, so drop the orphaned chunks
+		_, deleteErr := s.db.Collection("fs.chunks").DeleteMany(ctx, bson.M{"files_id": uploadStream.FileID})
+		if deleteErr != nil {
+			return errors.Join(err, deleteErr)
+		}
+		return err
+	}
+	return nil
+}
+
+// currentFile returns the metadata of the latest revision for precondition
+// checks, hashing the content of files uploaded before hashes were stored
+func (s *GridFSStore) currentFile(latest *gridFSFile) (*ConfigFile, error) {
+	if latest == nil {
+		return nil, nil
+	}
+
+	current := latest.configFile()
+	if current.Hash == "" {
+		data, err := s.download(latest.ID)
+		if err != nil {
+			return nil, err
+		}
+		current.Hash = contentHash(data)
+	}
+	return current, nil
+}
+
+// ensureIndexes creates the index that keeps revision numbers unique per
+// file and the indexes that keep one grant per file and grantee and one
+// schema per namespace and pattern
+func (s *GridFSStore) ensureIndexes(ctx context.Context) error {
+	s.indexMu.Lock()
+	defer s.indexMu.Unlock()
+
+	if s.indexed {
+		return nil
+	}
+
+	model := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "metadata.userID", Value: 1},
+			{Key: "filename", Value: 1},
+			{Key: "metadata.revision", Value: 1},
+		},
+		Options: options.Index().
+			SetName("config_revision").
+			SetUnique(true).
+			SetPartialFilterExpression(bson.M{"metadata.revision": bson.M{"$exists": true}}),
+	}
+	if _, err := s.db.Collection("fs.files").Indexes().CreateOne(ctx, model); err != nil {
+		return err
+	}
+
+	model = mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "user_id", Value: 1},
+			{Key: "filename", Value: 1},
+			{Key: "grantee", Value: 1},
+		},
+		Options: options.Index().SetName("config_grant").SetUnique(true),
+	}
+	if _, err := s.grants().Indexes().CreateOne(ctx, model); err != nil {
+		return err
+	}
+
+	model = mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "user_id", Value: 1},
+			{Key: "pattern", Value: 1},
+		},
+		Options: options.Index().SetName("config_schema").SetUnique(true),
+	}
+	if _, err := s.schemas().Indexes().CreateOne(ctx, model); err != nil {
+		return err
+	}
+
+	s.indexed = true
+	return nil
+}
+
+// Get downloads the latest revision of a file from GridFS
+func (s *GridFSStore) Get(ctx context.Context, userID, filename string) ([]byte, *ConfigFile, error) {
+	file, err := s.find(ctx, userID, filename)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data, err := s.download(file.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	configFile := file.configFile()
+	if configFile.Hash == "" {
+		configFile.Hash = contentHash(data)
+	}
+	return data, configFile, nil
+}
+
+// Delete removes every revision of a file from GridFS
+func (s *GridFSStore) Delete(ctx context.Context, userID, filename string, cond Precondition) error {
+	bucket, err := gridfs.NewBucket(s.db)
+	if err != nil {
+		return err
+	}
+
+	revisions, err := s.findAll(ctx, userID, filename)
+	if err != nil {
+		return err
+	}
+	if len(revisions) == 0 {
+		return ErrFileNotFound
+	}
+
+	current, err := s.currentFile(revisions[len(revisions)-1])
+	if err != nil {
+		return err
+	}
+	if err := cond.check(current); err != nil {
+		return err
+	}
+
+	// Replicas learn which file the deleted documents belonged to from the
+	// stamp on the latest revision
+	latest := revisions[len(revisions)-1]
+	if err := s.stampChange(ctx, latest.ID, gridFSChange{UserID: userID, From: filename}); err != nil {
+		return err
+	}
+
+	for _, revision := range revisions {
+		if err := bucket.Delete(revision.ID); err != nil {
+			return err
+		}
+	}
+
+	_, err = s.grants().DeleteMany(ctx, bson.M{"user_id": userID, "filename": filename})
+	return err
+}
+
+// Rename moves every revision of a file to a new filename
+func (s *GridFSStore) Rename(ctx context.Context, userID, from, to string, cond Precondition) error {
+	latest, err := s.find(ctx, userID, from)
+	if err != nil {
+		return err
+	}
+	current, err := s.currentFile(latest)
+	if err != nil {
+		return err
+	}
+	if err := cond.check(current); err != nil {
+		return err
+	}
+	if _, err := s.find(ctx, userID, to); err == nil {
+		return ErrFileExists
+	} else if !errors.Is(err, ErrFileNotFound) {
+		return err
+	}
+
+	filter := bson.M{
+		"filename":        from,
+		"metadata.userID": userID,
+	}
+	_, err = s.db.Collection("fs.files").UpdateMany(ctx, filter, bson.M{"$set": bson.M{"filename": to}})
+	if mongo.IsDuplicateKeyError(err) {
+		return ErrFileExists
+	}
+	if err != nil {
+		return err
+	}
+
+	filter = bson.M{"user_id": userID, "filename": from}
+	if _, err := s.grants().UpdateMany(ctx, filter, bson.M{"$set": bson.M{"filename": to}}); err != nil {
+		return err
+	}
+
+	return s.stampChange(ctx, latest.ID, gridFSChange{UserID: userID, From: from, To: to})
+}
+
+// List returns the latest revision of the files stored in GridFS for a user
+func (s *GridFSStore) List(ctx context.Context, userID string, opts ListOptions) ([]*ConfigFile, error) {
+	filenameFilter := bson.M{"$gt": opts.After}
+	if opts.Prefix != "" {
+		filenameFilter["$regex"] = "^" + regexp.QuoteMeta(opts.Prefix)
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"metadata.userID": userID, "filename": filenameFilter}}},
+		{{Key: "$sort", Value: bson.D{{Key: "filename", Value: 1}, {Key: "metadata.revision", Value: -1}, {Key: "uploadDate", Value: -1}}}},
+		{{Key: "$group", Value: bson.M{"_id": "$filename", "doc": bson.M{"$first": "$$ROOT"}}}},
+		{{Key: "$replaceRoot", Value: bson.M{"newRoot": "$doc"}}},
+		{{Key: "$sort", Value: bson.D{{Key: "filename", Value: 1}}}},
+	}
+	if opts.Limit > 0 {
+		pipeline = append(pipeline, bson.D{{Key: "$limit", Value: opts.Limit}})
+	}
+	cursor, err := s.db.Collection("fs.files").Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var files []*ConfigFile
+	for cursor.Next(ctx) {
+		var file gridFSFile
+		if err := cursor.Decode(&file); err != nil {
+			return nil, err
+		}
+		files = append(files, file.configFile())
+	}
+
+	return files, cursor.Err()
+}
+
+// Stat returns the metadata of the latest revision of a file
+func (s *GridFSStore) Stat(ctx context.Context, userID, filename string) (*ConfigFile, error) {
+	file, err := s.find(ctx, userID, filename)
+	if err != nil {
+		return nil, err
+	}
+	return file.configFile(), nil
+}
+
+// Revisions returns every revision of a file stored in GridFS
+func (s *GridFSStore) Revisions(ctx context.Context, userID, filename string) ([]*Revision, error) {
+	files, err := s.findAll(ctx, userID, filename)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, ErrFileNotFound
+	}
+
+	revisions := make([]*Revision, 0, len(files))
+	for _, file := range files {
+		revisions = append(revisions, file.revision())
+	}
+	return revisions, nil
+}
+
+// GetRevision downloads a specific revision of a file from GridFS
+func (s *GridFSStore) GetRevision(ctx context.Context, userID, filename string, number int) ([]byte, *Revision, error) {
+	filter := bson.M{
+		"filename":          filename,
+		"metadata.userID":   userID,
+		"metadata.revision": number,
+	}
+	if number == 1 {
+		filter["metadata.revision"] = bson.M{"$in": bson.A{1, nil}}
+	}
+
+	var file gridFSFile
+	err := s.db.Collection("fs.files").FindOne(ctx, filter).Decode(&file)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			if _, statErr := s.find(ctx, userID, filename); statErr != nil {
+				return nil, nil, statErr
+			}
+			return nil, nil, ErrRevisionNotFound
+		}
+		return nil, nil, err
+	}
+
+	data, err := s.download(file.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	revision := file.revision()
+	if revision.Hash == "" {
+		revision.Hash = contentHash(data)
+	}
+	return data, revision, nil
+}
+
+// download reads the content of a GridFS file
+func (s *GridFSStore) download(fileID primitive.ObjectID) ([]byte, error) {
+	bucket, err := gridfs.NewBucket(s.db)
+	if err != nil {
+		return nil, err
+	}
+
+	downloadStream, err := bucket.OpenDownloadStream(fileID)
+	if err != nil {
+		return nil, err
+	}
+	defer downloadStream.Close()
+
+	return io.ReadAll(downloadStream)
+}
+
+// find looks up the fs.files document of the latest revision of a file
+func (s *GridFSStore) find(ctx context.Context, userID, filename string) (*gridFSFile, error) {
+	filter := bson.M{
+		"filename":        filename,
+		"metadata.userID": userID,
+	}
+	findOpts := options.FindOne().SetSort(bson.D{{Key: "metadata.revision", Value: -1}, {Key: "uploadDate", Value: -1}})
+
+	var file gridFSFile
+	err := s.db.Collection("fs.files").FindOne(ctx, filter, findOpts).Decode(&file)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, ErrFileNotFound
+		}
+		return nil, err
+	}
+
+	return &file, nil
+}
+
+// findAll looks up the fs.files documents of every revision of a file,
+// oldest first
+func (s *GridFSStore) findAll(ctx context.Context, userID, filename string) ([]*gridFSFile, error) {
+	filter := bson.M{
+		"filename":        filename,
+		"metadata.userID": userID,
+	}
+	findOpts := options.Find().SetSort(bson.D{{Key: "metadata.revision", Value: 1}, {Key: "uploadDate", Value: 1}})
+
+	cursor, err := s.db.Collection("fs.files").Find(ctx, filter, findOpts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var files []*gridFSFile
+	for cursor.Next(ctx) {
+		var file gridFSFile
+		if err := cursor.Decode(&file); err != nil {
+			return nil, err
+		}
+		files = append(files, &file)
+	}
+
+	return files, cursor.Err()
+}
+
+// gridFSGrant is a document of the config_grants collection
+type gridFSGrant struct {
+	UserID   string `bson:"user_id"`
+	Filename string `bson:"filename"`
+	Grant    `bson:",inline"`
+}
+
+// grants returns the collection holding the grants of every file
+func (s *GridFSStore) grants() *mongo.Collection {
+	return s.db.Collection("config_grants")
+}
+
+// Grants returns the grants of a file ordered by grantee
+func (s *GridFSStore) Grants(ctx context.Context, userID, filename string) ([]Grant, error) {
+	if _, err := s.find(ctx, userID, filename); err != nil {
+		return nil, err
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "grantee", Value: 1}})
+	cursor, err := s.grants().Find(ctx, bson.M{"user_id": userID, "filename": filename}, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var docs []gridFSGrant
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+	grants := make([]Grant, 0, len(docs))
+	for _, doc := range docs {
+		grants = append(grants, doc.Grant)
+	}
+	return grants, nil
+}
+
+// SetGrant upserts or deletes the grant of a file to a single grantee
+func (s *GridFSStore) SetGrant(ctx context.Context, userID, filename string, grant Grant) error {
+	if err := s.ensureIndexes(ctx); err != nil {
+		return err
+	}
+	if _, err := s.find(ctx, userID, filename); err != nil {
+		return err
+	}
+
+	filter := bson.M{"user_id": userID, "filename": filename, "grantee": grant.Grantee}
+	if grant.Access == AccessNone {
+		_, err := s.grants().DeleteOne(ctx, filter)
+		return err
+	}
+
+	update := bson.M{"$set": bson.M{"access": grant.Access}}
+	_, err := s.grants().UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	return err
+}
+
+// RevokeGrantee deletes the grants to a single grantee from every file
+func (s *GridFSStore) RevokeGrantee(ctx context.Context, grantee string) error {
+	_, err := s.grants().DeleteMany(ctx, bson.M{"grantee": grantee})
+	return err
+}
+
+// gridFSSchema is a document of the config_schemas collection
+type gridFSSchema struct {
+	UserID    string    `bson:"user_id"`
+	Pattern   string    `bson:"pattern"`
+	Schema    string    `bson:"schema"`
+	Author    string    `bson:"author"`
+	UpdatedAt time.Time `bson:"updated_at"`
+}
+
+// schemas returns the collection holding the schemas of every namespace
+func (s *GridFSStore) schemas() *mongo.Collection {
+	return s.db.Collection("config_schemas")
+}
+
+// Schemas returns the schemas of a namespace ordered by pattern
+func (s *GridFSStore) Schemas(ctx context.Context, userID string) ([]*Schema, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "pattern", Value: 1}})
+	cursor, err := s.schemas().Find(ctx, bson.M{"user_id": userID}, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var docs []gridFSSchema
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+	schemas := make([]*Schema, 0, len(docs))
+	for _, doc := range docs {
+		schemas = append(schemas, &Schema{
+			Pattern:   doc.Pattern,
+			Schema:    json.RawMessage(doc.Schema),
+			Author:    doc.Author,
+			UpdatedAt: doc.UpdatedAt,
+		})
+	}
+	return schemas, nil
+}
+
+// PutSchema upserts the schema of a namespace and pattern
+func (s *GridFSStore) PutSchema(ctx context.Context, userID string, schema *Schema) error {
+	if err := s.ensureIndexes(ctx); err != nil {
+		return err
+	}
+
+	filter := bson.M{"user_id": userID, "pattern": schema.Pattern}
+	update := bson.M{"$set": bson.M{
+		"schema":     string(schema.Schema),
+		"author":     schema.Author,
+		"updated_at": schema.UpdatedAt,
+	}}
+	_, err := s.schemas().UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	return err
+}
+
+// DeleteSchema deletes the schema of a namespace and pattern
+func (s *GridFSStore) DeleteSchema(ctx context.Context, userID, pattern string) error {
+	result, err := s.schemas().DeleteOne(ctx, bson.M{"user_id": userID, "pattern": pattern})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return ErrSchemaNotFound
+	}
+	return nil
+}
@@ -0,0 +1,328 @@
+package configurations
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// splitLines splits content into lines without their line endings
+func splitLines(data []byte) []string {
+	lines := strings.Split(string(data), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSuffix(line, "\r")
+	}
+	return lines
+}
+
+// decodeINI decodes an INI file. Keys before the first section are kept at
+// the top level and every section becomes an object. Keys and values are
+// separated by "=" or ":", lines starting with ";" or "#" are comments and
+// quotes around values are removed.
+func decodeINI(data []byte) (map[string]interface{}, *ValidationError) {
+	doc := make(map[string]interface{})
+	section := doc
+	for i, line := range splitLines(data) {
+		trimmed := strings.TrimSpace(line)
+		column := strings.Index(line, trimmed) + 1
+		if trimmed == "" || trimmed[0] == ';' || trimmed[0] == '#' {
+			continue
+		}
+
+		if trimmed[0] == '[' {
+			if !strings.HasSuffix(trimmed, "]") {
+				return nil, &ValidationError{Line: i + 1, Column: column, Message: "section header is missing ]"}
+			}
+			name := strings.TrimSpace(trimmed[1 : len(trimmed)-1])
+			if name == "" {
+				return nil, &ValidationError{Line: i + 1, Column: column, Message: "empty section name"}
+			}
+			existing, exists := doc[name]
+			if !exists {
+				existing = make(map[string]interface{})
+				doc[name] = existing
+			}
+			var ok bool
+			if section, ok = existing.(map[string]interface{}); !ok {
+				return nil, &ValidationError{Line: i + 1, Column: column, Message: "section [" + name + "] conflicts with a key"}
+			}
+			continue
+		}
+
+		separator := strings.IndexAny(trimmed, "=:")
+		if separator < 0 {
+			return nil, &ValidationError{Line: i + 1, Column: column, Message: "expected key = value"}
+		}
+		key := strings.TrimSpace(trimmed[:separator])
+		if key == "" {
+			return nil, &ValidationError{Line: i + 1, Column: column, Message: "missing key"}
+		}
+		if _, isSection := section[key].(map[string]interface{}); isSection {
+			return nil, &ValidationError{Line: i + 1, Column: column, Message: "key " + key + " conflicts with a section"}
+		}
+		section[key] = unquote(strings.TrimSpace(trimmed[separator+1:]))
+	}
+	return doc, nil
+}
+
+// unquote removes matching single or double quotes around a value
+func unquote(value string) string {
+	if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+		return value[1 : len(value)-1]
+	}
+	return value
+}
+
+// decodeProperties decodes a Java properties file as described for
+// java.util.Properties.load. Keys are kept as they are, so "a.b" is a
+// single key.
+func decodeProperties(data []byte) (map[string]interface{}, *ValidationError) {
+	doc := make(map[string]interface{})
+	lines := splitLines(data)
+	for i := 0; i < len(lines); i++ {
+		start := i
+		line := strings.TrimLeft(lines[i], " \t\f")
+		if line == "" || line[0] == '#' || line[0] == '!' {
+			continue
+		}
+		// A line ending in an odd number of backslashes continues on the
+		// next one
+		for continues(line) && i+1 < len(lines) {
+			i++
+			line = line[:len(line)-1] + strings.TrimLeft(lines[i], " \t\f")
+		}
+		if continues(line) {
+			line = line[:len(line)-1]
+		}
+
+		keyEnd := len(line)
+		for j := 0; j < len(line); j++ {
+			if line[j] == '\\' {
+				j++
+				continue
+			}
+			if strings.IndexByte("=: \t\f", line[j]) >= 0 {
+				keyEnd = j
+				break
+			}
+		}
+		value := strings.TrimLeft(line[keyEnd:], " \t\f")
+		if value != "" && (value[0] == '=' || value[0] == ':') {
+			value = strings.TrimLeft(value[1:], " \t\f")
+		}
+
+		key, err := unescapeProperty(line[:keyEnd])
+		if err == nil {
+			value, err = unescapeProperty(value)
+		}
+		if err != nil {
+			err.Line = start + 1
+			return nil, err
+		}
+		doc[key] = value
+	}
+	return doc, nil
+}
+
+// continues reports whether a properties line ends in an odd number of
+// backslashes
+func continues(line string) bool {
+	trailing := len(line) - len(strings.TrimRight(line, "\\"))
+	return trailing%2 == 1
+}
+
+// unescapeProperty resolves the escapes of a properties key or value
+func unescapeProperty(s string) (string, *ValidationError) {
+	if !strings.Contains(s, "\\") {
+		return s, nil
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i+1 == len(s) {
+			b.WriteByte(s[i])
+			continue
+		}
+		i++
+		switch s[i] {
+		case 't':
+			b.WriteByte('\t')
+		case 'n':
+			b.WriteByte('\n')
+		case 'r':
+			b.WriteByte('\r')
+		case 'f':
+			b.WriteByte('\f')
+		case 'u':
+			r, ok := escapedRune(s, i+1)
+			if !ok {
+				return "", &ValidationError{Message: "malformed \\uxxxx encoding"}
+			}
+			i += 4
+			// Characters outside the BMP are escaped as surrogate pairs
+			if utf16.IsSurrogate(r) && strings.HasPrefix(s[i+1:], "\\u") {
+				if low, ok := escapedRune(s, i+3); ok {
+					if pair := utf16.DecodeRune(r, low); pair != utf8.RuneError {
+						r = pair
+						i += 6
+					}
+				}
+			}
+			b.WriteRune(r)
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return b.String(), nil
+}
+
+// escapedRune parses the four hex digits of a \uxxxx escape at s[i:]
+func escapedRune(s string, i int) (rune, bool) {
+	if i+4 > len(s) {
+		return 0, false
+	}
+	code, err := strconv.ParseUint(s[i:i+4], 16, 16)
+	if err != nil {
+		return 0, false
+	}
+	return rune(code), true
+}
+
+// envName reports whether name is a valid environment variable name
+func envName(name string) bool {
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		return false
+	}
+	for _, r := range name {
+		if !(r == '_' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// decodeEnv decodes a .env file of NAME=value lines, optionally starting
+// with "export". Values follow shell quoting: single quotes are literal,
+// double quotes support \n, \", \\, \$ and \` escapes, and unquoted values
+// end at a comment. Variables are not expanded.
+func decodeEnv(data []byte) (map[string]interface{}, *ValidationError) {
+	doc := make(map[string]interface{})
+	pos := 0
+	errorAt := func(offset int, message string) *ValidationError {
+		l, c := position(data, int64(offset))
+		return &ValidationError{Line: l, Column: c, Message: message}
+	}
+
+	for pos < len(data) {
+		// Blank lines, indentation and comments
+		switch data[pos] {
+		case ' ', '\t', '\r', '\n':
+			pos++
+			continue
+		case '#':
+			for pos < len(data) && data[pos] != '\n' {
+				pos++
+			}
+			continue
+		}
+
+		if bytes.HasPrefix(data[pos:], []byte("export ")) || bytes.HasPrefix(data[pos:], []byte("export\t")) {
+			pos += len("export ")
+			for pos < len(data) && (data[pos] == ' ' || data[pos] == '\t') {
+				pos++
+			}
+		}
+
+		nameStart := pos
+		for pos < len(data) && data[pos] != '=' && data[pos] != '\n' && data[pos] != ' ' && data[pos] != '\t' {
+			pos++
+		}
+		name := string(data[nameStart:pos])
+		if !envName(name) {
+			return nil, errorAt(nameStart, "invalid variable name "+strconv.Quote(name))
+		}
+		for pos < len(data) && (data[pos] == ' ' || data[pos] == '\t') {
+			pos++
+		}
+		if pos == len(data) || data[pos] != '=' {
+			return nil, errorAt(pos, "expected = after "+name)
+		}
+		pos++
+
+		var value strings.Builder
+		var pending string
+	value:
+		for pos < len(data) {
+			c := data[pos]
+			switch {
+			case c == '\n' || c == '\r':
+				break value
+			case c == ' ' || c == '\t':
+				pending += string(c)
+				pos++
+				continue
+			case c == '#' && pending != "":
+				for pos < len(data) && data[pos] != '\n' {
+					pos++
+				}
+				pending = ""
+				break value
+			}
+			// Whitespace around values is dropped
+			if value.Len() > 0 {
+				value.WriteString(pending)
+			}
+			pending = ""
+
+			switch c {
+			case '\'':
+				end := bytes.IndexByte(data[pos+1:], '\'')
+				if end < 0 {
+					return nil, errorAt(pos, "unterminated single quote")
+				}
+				value.Write(data[pos+1 : pos+1+end])
+				pos += end + 2
+			case '"':
+				quote := pos
+				pos++
+				for {
+					if pos == len(data) {
+						return nil, errorAt(quote, "unterminated double quote")
+					}
+					if data[pos] == '"' {
+						pos++
+						break
+					}
+					if data[pos] == '\\' && pos+1 < len(data) {
+						pos++
+						switch data[pos] {
+						case 'n':
+							value.WriteByte('\n')
+						case '"', '\\', '$', '`':
+							value.WriteByte(data[pos])
+						default:
+							value.WriteByte('\\')
+							value.WriteByte(data[pos])
+						}
+						pos++
+						continue
+					}
+					value.WriteByte(data[pos])
+					pos++
+				}
+			case '\\':
+				if pos+1 < len(data) && data[pos+1] != '\n' {
+					value.WriteByte(data[pos+1])
+				}
+				pos += 2
+			default:
+				value.WriteByte(c)
+				pos++
+			}
+		}
+		doc[name] = value.String()
+	}
+	return doc, nil
+}
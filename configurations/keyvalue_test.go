@@ -0,0 +1,308 @@
+package configurations
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// decodeErrorTest is a case of content a key/value decoder rejects
+type decodeErrorTest struct {
+	name    string
+	data    string
+	line    int
+	column  int
+	message string
+}
+
+func checkDecodeError(t *testing.T, err *ValidationError, test decodeErrorTest) {
+	t.Helper()
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if err.Line != test.line || err.Column != test.column {
+		t.Errorf("position = %d:%d, want %d:%d", err.Line, err.Column, test.line, test.column)
+	}
+	if !strings.Contains(err.Message, test.message) {
+		t.Errorf("message = %q, want it to contain %q", err.Message, test.message)
+	}
+}
+
+func TestDecodeINI(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want map[string]interface{}
+	}{
+		{
+			name: "keys before the first section stay at the top",
+			data: "name = app\n[server]\nhost = example.com\nport: 8080\n",
+			want: map[string]interface{}{
+				"name":   "app",
+				"server": map[string]interface{}{"host": "example.com", "port": "8080"},
+			},
+		},
+		{
+			name: "comments and blank lines",
+			data: "; comment\n# comment\n\n  [s]  \n  a = 1  \n",
+			want: map[string]interface{}{"s": map[string]interface{}{"a": "1"}},
+		},
+		{
+			name: "quotes are removed",
+			data: "[s]\na = \"x y\"\nb = 'z'\nc = \"unbalanced'\n",
+			want: map[string]interface{}{"s": map[string]interface{}{"a": "x y", "b": "z", "c": "\"unbalanced'"}},
+		},
+		{
+			name: "repeated sections are merged",
+			data: "[s]\na = 1\n[t]\n[s]\nb = 2\n",
+			want: map[string]interface{}{
+				"s": map[string]interface{}{"a": "1", "b": "2"},
+				"t": map[string]interface{}{},
+			},
+		},
+		{
+			name: "the first separator splits",
+			data: "url = http://example.com/?a=b\n",
+			want: map[string]interface{}{"url": "http://example.com/?a=b"},
+		},
+		{
+			name: "CRLF line endings",
+			data: "[s]\r\na = 1\r\n",
+			want: map[string]interface{}{"s": map[string]interface{}{"a": "1"}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := decodeINI([]byte(test.data))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %#v, want %#v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestDecodeINIErrors(t *testing.T) {
+	tests := []decodeErrorTest{
+		{name: "unterminated section", data: "[server\n", line: 1, column: 1, message: "missing ]"},
+		{name: "empty section name", data: "a = 1\n  [ ]\n", line: 2, column: 3, message: "empty section name"},
+		{name: "line without separator", data: "[s]\n  just text\n", line: 2, column: 3, message: "expected key = value"},
+		{name: "missing key", data: "= 1\n", line: 1, column: 1, message: "missing key"},
+		{name: "section named like a key", data: "s = 1\n[s]\n", line: 2, column: 1, message: "conflicts with a key"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := decodeINI([]byte(test.data))
+			checkDecodeError(t, err, test)
+		})
+	}
+}
+
+func TestDecodeProperties(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want map[string]interface{}
+	}{
+		{
+			name: "separators",
+			data: "a=1\nb: 2\nc 3\nd = 4\ne\n",
+			want: map[string]interface{}{"a": "1", "b": "2", "c": "3", "d": "4", "e": ""},
+		},
+		{
+			name: "comments",
+			data: "# comment\n! comment\n   f = 5\n",
+			want: map[string]interface{}{"f": "5"},
+		},
+		{
+			name: "dotted keys are not nested",
+			data: "db.host=localhost\n",
+			want: map[string]interface{}{"db.host": "localhost"},
+		},
+		{
+			name: "continuation lines drop their indentation",
+			data: "list = a,\\\n    b,\\\n    c\nnext = 1\n",
+			want: map[string]interface{}{"list": "a,b,c", "next": "1"},
+		},
+		{
+			name: "an escaped backslash does not continue",
+			data: "path = C:\\\\\nnext = 1\n",
+			want: map[string]interface{}{"path": `C:\`, "next": "1"},
+		},
+		{
+			name: "continuation at the end of the file",
+			data: "a = b\\",
+			want: map[string]interface{}{"a": "b"},
+		},
+		{
+			name: "escaped separators and spaces in keys",
+			data: "key\\ with\\ spaces = v\na\\=b\\:c = d\n",
+			want: map[string]interface{}{"key with spaces": "v", "a=b:c": "d"},
+		},
+		{
+			name: "escape sequences",
+			data: "v = tab\\there\\nnew\\rline\\fend\\q\n",
+			want: map[string]interface{}{"v": "tab\there\nnew\rline\fendq"},
+		},
+		{
+			name: "unicode escapes",
+			data: "greeting = caf\\u00e9\nsmile = \\ud83d\\ude00\n",
+			want: map[string]interface{}{"greeting": "café", "smile": "😀"},
+		},
+		{
+			name: "lone surrogate",
+			data: "v = \\ud83dx\n",
+			want: map[string]interface{}{"v": "\uFFFDx"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := decodeProperties([]byte(test.data))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %#v, want %#v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestDecodePropertiesErrors(t *testing.T) {
+	tests := []decodeErrorTest{
+		{name: "short unicode escape", data: "a = \\u12\n", line: 1, message: "malformed"},
+		{name: "invalid hex digits", data: "a = 1\nb = \\uZZZZ\n", line: 2, message: "malformed"},
+		{name: "escape in a continued entry", data: "a = 1\nb = x\\\n  \\u1\n", line: 2, message: "malformed"},
+		{name: "malformed key", data: "\\u00 = 1\n", line: 1, message: "malformed"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := decodeProperties([]byte(test.data))
+			checkDecodeError(t, err, test)
+		})
+	}
+}
+
+func TestDecodeEnv(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want map[string]interface{}
+	}{
+		{
+			name: "plain values",
+			data: "A=1\nB=two\n\n# comment\n  C=3\n",
+			want: map[string]interface{}{"A": "1", "B": "two", "C": "3"},
+		},
+		{
+			name: "export",
+			data: "export A=1\nexport\tB=2\n",
+			want: map[string]interface{}{"A": "1", "B": "2"},
+		},
+		{
+			name: "whitespace around the separator and value",
+			data: "A = 1  \nB=\n",
+			want: map[string]interface{}{"A": "1", "B": ""},
+		},
+		{
+			name: "single quotes are literal",
+			data: `A='$HOME \n "x"'` + "\n",
+			want: map[string]interface{}{"A": `$HOME \n "x"`},
+		},
+		{
+			name: "double quote escapes",
+			data: `A="a\"b\\c\$d\` + "`" + `e\nf"` + "\n",
+			want: map[string]interface{}{"A": "a\"b\\c$d`e\nf"},
+		},
+		{
+			name: "unknown escapes in double quotes are kept",
+			data: `A="\t\x"` + "\n",
+			want: map[string]interface{}{"A": `\t\x`},
+		},
+		{
+			name: "quoted values span lines",
+			data: "A=\"line1\nline2\"\nB='x\ny'\n",
+			want: map[string]interface{}{"A": "line1\nline2", "B": "x\ny"},
+		},
+		{
+			name: "comments need whitespace before them",
+			data: "A=b # comment\nB=c#d\nC='e' #f\n",
+			want: map[string]interface{}{"A": "b", "B": "c#d", "C": "e"},
+		},
+		{
+			name: "quoted and unquoted parts are joined",
+			data: `A=foo'bar baz'"qux"` + "\n",
+			want: map[string]interface{}{"A": "foobar bazqux"},
+		},
+		{
+			name: "inner whitespace is kept",
+			data: "A=a  b\n",
+			want: map[string]interface{}{"A": "a  b"},
+		},
+		{
+			name: "backslashes outside quotes",
+			data: `A=a\ b\#c` + "\n",
+			want: map[string]interface{}{"A": "a b#c"},
+		},
+		{
+			name: "CRLF line endings",
+			data: "A=1\r\nB='2'\r\n",
+			want: map[string]interface{}{"A": "1", "B": "2"},
+		},
+		{
+			name: "later definitions win",
+			data: "A=1\nA=2\n",
+			want: map[string]interface{}{"A": "2"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := decodeEnv([]byte(test.data))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %#v, want %#v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestDecodeEnvErrors(t *testing.T) {
+	tests := []decodeErrorTest{
+		{name: "name starting with a digit", data: "1A=x\n", line: 1, column: 1, message: "invalid variable name"},
+		{name: "name with a dash", data: "A=1\n  MY-VAR=x\n", line: 2, column: 3, message: "invalid variable name"},
+		{name: "missing separator", data: "A=1\nB\n", line: 2, column: 2, message: "expected = after B"},
+		{name: "unterminated single quote", data: "A='x\n", line: 1, column: 3, message: "unterminated single quote"},
+		{name: "unterminated double quote", data: "A=1\nB=x\"y\n", line: 2, column: 4, message: "unterminated double quote"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := decodeEnv([]byte(test.data))
+			checkDecodeError(t, err, test)
+		})
+	}
+}
+
+func TestEnvName(t *testing.T) {
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{"PATH", true},
+		{"_private", true},
+		{"a1_B2", true},
+		{"", false},
+		{"1A", false},
+		{"A-B", false},
+		{"A.B", false},
+		{"É", false},
+	}
+	for _, test := range tests {
+		if got := envName(test.name); got != test.want {
+			t.Errorf("envName(%q) = %v, want %v", test.name, got, test.want)
+		}
+	}
+}
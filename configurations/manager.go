@@ -2,232 +2,245 @@ package configurations
 
 import (
 	"context"
-	"errors"
-	"io"
-	"io/ioutil"
-	"os"
-	"path/filepath"
-	"time"
-
-	"go.mongodb.org/mongo-driver/bson"
-	"go.mongodb.org/mongo-driver/bson/primitive"
-	"go.mongodb.org/mongo-driver/mongo"
-	"go.mongodb.org/mongo-driver/mongo/gridfs"
-	"go.mongodb.org/mongo-driver/mongo/options"
+	"encoding/base64"
+	"sync/atomic"
 )
 
 // ConfigManager handles configuration file operations
 type ConfigManager struct {
-	db        *mongo.Database
-	useFile   bool
-	configDir string
+	store Store
+	// bus notifies watchers of the changes made through the manager
+	bus *ChangeBus
+	// following is set while the bus is fed by the notifications of the
+	// store, see FollowStore
+	following atomic.Bool
 }
 
-// NewConfigManager creates a new configuration manager
-func NewConfigManager(db *mongo.Database, useFile bool, configDir string) *ConfigManager {
-	if configDir == "" {
-		configDir = "configurations"
-	}
+// NewConfigManager creates a new configuration manager backed by store
+func NewConfigManager(store Store) *ConfigManager {
 	return &ConfigManager{
-		db:        db,
-		useFile:   useFile,
-		configDir: configDir,
+		store: store,
+		bus:   NewChangeBus(),
 	}
 }
 
-// AddConfig adds a new configuration file to GridFS or local filesystem
-func (cm *ConfigManager) AddConfig(ctx context.Context, userID, filename string, fileType int, data []byte) error {
-	if cm.useFile {
-		return cm.addConfigToFile(userID, filename, data)
+// AddConfig adds a new configuration file to a namespace of the caller. An
+// empty namespace is the caller's own; TeamNamespace names the namespace of
+// a team. An unspecified file type is derived from the file name or the
+// content. The content must match the type and the schemas registered for
+// the file.
+func (cm *ConfigManager) AddConfig(ctx context.Context, caller Caller, namespace, filename string, fileType FileType, data []byte) (*Revision, error) {
+	namespace, err := authorizeNamespace(caller, namespace)
+	if err != nil {
+		return nil, err
 	}
-
-	bucket, err := gridfs.NewBucket(cm.db)
+	if err := validatePath(filename); err != nil {
+		return nil, err
+	}
+	fileType, err = resolveFileType(fileType, filename, data)
 	if err != nil {
-		return err
+		return nil, err
 	}
-
-	// Create metadata for the file
-	metadata := bson.M{
-		"userID":   userID,
-		"fileType": fileType,
-		"created":  time.Now(),
-		"updated":  time.Now(),
+	if err := cm.validateSchemas(ctx, namespace, filename, fileType, data); err != nil {
+		return nil, err
 	}
 
-	// Check if file already exists
-	if cm.fileExists(ctx, userID, filename) {
-		return errors.New("file already exists")
+	if err := cm.checkPathConflict(ctx, namespace, filename); err != nil {
+		return nil, err
 	}
 
-	uploadOpts := options.GridFSUpload().SetMetadata(metadata)
-	uploadStream, err := bucket.OpenUploadStream(filename, uploadOpts)
-	if err != nil {
-		return err
+	file := &ConfigFile{
+		UserID:   namespace,
+		Filename: filename,
+		FileType: fileType,
 	}
-	defer uploadStream.Close()
-
-	_, err = uploadStream.Write(data)
-	return err
+	rev := &Revision{Author: caller.UserID}
+	if err := cm.store.Put(ctx, file, rev, data, Precondition{NotExists: true}); err != nil {
+		return nil, err
+	}
+	cm.publishUpdate(namespace, filename, rev)
+	return rev, nil
 }
 
-// addConfigToFile adds a new configuration file to the local filesystem
-func (cm *ConfigManager) addConfigToFile(userID, filename string, data []byte) error {
-	// Create path to user's configuration directory
-	userDir := filepath.Join(cm.configDir, userID)
-
-	// Create user directory if it doesn't exist
-	if err := os.MkdirAll(userDir, 0755); err != nil {
-		return err
+// UpdateConfig stores data as a new revision of an existing configuration
+// file. The write is rejected with ErrPreconditionFailed unless the file is
+// still in the state described by cond. Files of other namespaces can be
+// updated with a write grant. An unspecified file type keeps the type of
+// the file.
+func (cm *ConfigManager) UpdateConfig(ctx context.Context, caller Caller, namespace, filename string, fileType FileType, data []byte, cond Precondition) (*Revision, error) {
+	namespace, err := cm.authorizeFile(ctx, caller, namespace, filename, AccessWrite)
+	if err != nil {
+		return nil, err
 	}
-
-	filePath := filepath.Join(userDir, filename)
-
-	// Check if file already exists
-	if _, err := os.Stat(filePath); err == nil {
-		return errors.New("file already exists")
+	current, err := cm.store.Stat(ctx, namespace, filename)
+	if err != nil {
+		return nil, err
 	}
-
-	// Write file
-	return ioutil.WriteFile(filePath, data, 0644)
-}
-
-// UpdateConfig updates an existing configuration file in GridFS or local filesystem
-func (cm *ConfigManager) UpdateConfig(ctx context.Context, userID, filename string, fileType int, data []byte) error {
-	if err := cm.DeleteConfig(ctx, userID, filename); err != nil {
-		return err
+	if fileType == FileTypeUnspecified {
+		fileType = current.FileType
+	}
+	fileType, err = resolveFileType(fileType, filename, data)
+	if err != nil {
+		return nil, err
+	}
+	if err := cm.validateSchemas(ctx, namespace, filename, fileType, data); err != nil {
+		return nil, err
 	}
 
-	return cm.AddConfig(ctx, userID, filename, fileType, data)
-}
-
-func (cm *ConfigManager) DeleteConfig(ctx context.Context, userID, filename string) error {
-	if cm.useFile {
-		return cm.deleteConfigFromFile(userID, filename)
+	file := &ConfigFile{
+		UserID:   namespace,
+		Filename: filename,
+		FileType: fileType,
 	}
+	rev := &Revision{Author: caller.UserID}
+	if err := cm.store.Put(ctx, file, rev, data, cond); err != nil {
+		return nil, err
+	}
+	cm.publishUpdate(namespace, filename, rev)
+	return rev, nil
+}
 
-	bucket, err := gridfs.NewBucket(cm.db)
+// DeleteConfig removes a configuration file and its history from the store
+// if the file is still in the state described by cond. Grants do not allow
+// deleting files of other namespaces.
+func (cm *ConfigManager) DeleteConfig(ctx context.Context, caller Caller, namespace, filename string, cond Precondition) error {
+	namespace, err := authorizeNamespace(caller, namespace)
 	if err != nil {
 		return err
 	}
-
-	fileID, err := cm.getFileID(ctx, userID, filename)
-	if err != nil {
+	if err := validatePath(filename); err != nil {
 		return err
 	}
-
-	return bucket.Delete(fileID)
-}
-
-func (cm *ConfigManager) deleteConfigFromFile(userID, filename string) error {
-	userDir := filepath.Join(cm.configDir, userID)
-	filePath := filepath.Join(userDir, filename)
-
-	if _, err := os.Stat(filePath); os.IsNotExist(err) {
-		return errors.New("file not found")
+	if err := cm.store.Delete(ctx, namespace, filename, cond); err != nil {
+		return err
 	}
-
-	return os.Remove(filePath)
+	cm.publishDelete(namespace, filename)
+	return nil
 }
 
-func (cm *ConfigManager) GetConfig(ctx context.Context, userID, filename string) ([]byte, int, error) {
-	if cm.useFile {
-		return cm.getConfigFromFile(userID, filename)
-	}
-
-	bucket, err := gridfs.NewBucket(cm.db)
+// GetConfig returns the content and metadata of a configuration file. The
+// content hash of the metadata serves as the file's ETag.
+func (cm *ConfigManager) GetConfig(ctx context.Context, caller Caller, namespace, filename string) ([]byte, *ConfigFile, error) {
+	namespace, err := cm.authorizeFile(ctx, caller, namespace, filename, AccessRead)
 	if err != nil {
-		return nil, 0, err
+		return nil, nil, err
 	}
+	return cm.store.Get(ctx, namespace, filename)
+}
 
-	fileID, err := cm.getFileID(ctx, userID, filename)
+const (
+	// defaultListLimit is the page size of ListConfigs when none is requested
+	defaultListLimit = 100
+	// maxListLimit is the largest page size ListConfigs returns
+	maxListLimit = 1000
+)
+
+// ListConfigs returns a page of the configuration files of a namespace
+// whose names start with prefix, ordered by filename. cursor is empty for
+// the first page and otherwise the next cursor returned with the previous
+// page; the returned cursor is empty once the listing is complete.
+func (cm *ConfigManager) ListConfigs(ctx context.Context, caller Caller, namespace, prefix, cursor string, limit int) ([]*ConfigFile, string, error) {
+	namespace, err := authorizeNamespace(caller, namespace)
 	if err != nil {
-		return nil, 0, err
+		return nil, "", err
 	}
-
-	var file struct {
-		Metadata struct {
-			FileType int    `bson:"fileType"`
-			UserID   string `bson:"userID"`
-		} `bson:"metadata"`
+	if err := validatePrefix(prefix); err != nil {
+		return nil, "", err
 	}
 
-	err = cm.db.Collection("fs.files").FindOne(ctx, bson.M{"_id": fileID}).Decode(&file)
-	if err != nil {
-		return nil, 0, err
+	if limit <= 0 {
+		limit = defaultListLimit
 	}
-
-	if file.Metadata.UserID != userID {
-		return nil, 0, errors.New("unauthorized access to file")
+	if limit > maxListLimit {
+		limit = maxListLimit
 	}
 
-	downloadStream, err := bucket.OpenDownloadStream(fileID)
+	after, err := decodeCursor(cursor)
 	if err != nil {
-		return nil, 0, err
+		return nil, "", err
 	}
-	defer downloadStream.Close()
 
-	data, err := io.ReadAll(downloadStream)
+	// Ask for one more file than requested to learn whether another page follows
+	files, err := cm.store.List(ctx, namespace, ListOptions{Prefix: prefix, After: after, Limit: limit + 1})
 	if err != nil {
-		return nil, 0, err
+		return nil, "", err
 	}
 
-	return data, file.Metadata.FileType, nil
+	next := ""
+	if len(files) > limit {
+		files = files[:limit]
+		next = encodeCursor(files[limit-1].Filename)
+	}
+	return files, next, nil
 }
 
-func (cm *ConfigManager) getConfigFromFile(userID, filename string) ([]byte, int, error) {
-	userDir := filepath.Join(cm.configDir, userID)
-	filePath := filepath.Join(userDir, filename)
+// encodeCursor turns the last filename of a page into an opaque cursor
+func encodeCursor(filename string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(filename))
+}
 
-	if _, err := os.Stat(filePath); os.IsNotExist(err) {
-		return nil, 0, errors.New("file not found")
+// decodeCursor returns the filename a cursor continues after
+func decodeCursor(cursor string) (string, error) {
+	after, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return "", ErrInvalidCursor
 	}
+	return string(after), nil
+}
 
-	data, err := ioutil.ReadFile(filePath)
+// ListRevisions returns the revision history of a configuration file
+func (cm *ConfigManager) ListRevisions(ctx context.Context, caller Caller, namespace, filename string) ([]*Revision, error) {
+	namespace, err := cm.authorizeFile(ctx, caller, namespace, filename, AccessRead)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
-
-	fileType := determineFileType(filepath.Ext(filename))
-
-	return data, fileType, nil
+	return cm.store.Revisions(ctx, namespace, filename)
 }
 
-func (cm *ConfigManager) fileExists(ctx context.Context, userID, filename string) bool {
-	_, err := cm.getFileID(ctx, userID, filename)
-	return err == nil
+// GetRevision returns the content of a specific revision of a configuration file
+func (cm *ConfigManager) GetRevision(ctx context.Context, caller Caller, namespace, filename string, revision int) ([]byte, *Revision, error) {
+	namespace, err := cm.authorizeFile(ctx, caller, namespace, filename, AccessRead)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cm.store.GetRevision(ctx, namespace, filename, revision)
 }
 
-func (cm *ConfigManager) getFileID(ctx context.Context, userID, filename string) (primitive.ObjectID, error) {
-	var file struct {
-		ID primitive.ObjectID `bson:"_id"`
+// RollbackConfig restores the content of a previous revision of a
+// configuration file. The rollback is recorded as a new revision so the
+// history stays linear. Like any write, the content must match its type and
+// the schemas registered for the file.
+func (cm *ConfigManager) RollbackConfig(ctx context.Context, caller Caller, namespace, filename string, revision int) (*Revision, error) {
+	namespace, err := cm.authorizeFile(ctx, caller, namespace, filename, AccessWrite)
+	if err != nil {
+		return nil, err
 	}
 
-	filter := bson.M{
-		"filename":        filename,
-		"metadata.userID": userID,
+	data, target, err := cm.store.GetRevision(ctx, namespace, filename, revision)
+	if err != nil {
+		return nil, err
 	}
-
-	err := cm.db.Collection("fs.files").FindOne(ctx, filter).Decode(&file)
+	fileType, err := resolveFileType(target.FileType, filename, data)
 	if err != nil {
-		return primitive.NilObjectID, err
+		return nil, err
+	}
+	if err := cm.validateSchemas(ctx, namespace, filename, fileType, data); err != nil {
+		return nil, err
 	}
 
-	return file.ID, nil
-}
-
-func determineFileType(ext string) int {
-	switch ext {
-	case ".txt":
-		return 1
-	case ".csv":
-		return 2
-	case ".json":
-		return 3
-	case ".xml":
-		return 4
-	case ".yaml", ".yml":
-		return 5
-	default:
-		return 1
+	file := &ConfigFile{
+		UserID:   namespace,
+		Filename: filename,
+		FileType: fileType,
 	}
+	rev := &Revision{
+		Author:     caller.UserID,
+		RollbackOf: target.Number,
+	}
+	if err := cm.store.Put(ctx, file, rev, data, Precondition{}); err != nil {
+		return nil, err
+	}
+	cm.publishUpdate(namespace, filename, rev)
+
+	return rev, nil
 }
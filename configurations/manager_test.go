@@ -0,0 +1,110 @@
+package configurations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+// listAll pages through ListConfigs and returns the filenames of every page
+func listAll(t *testing.T, cm *ConfigManager, prefix string, limit int) [][]string {
+	t.Helper()
+	var pages [][]string
+	cursor := ""
+	for {
+		files, next, err := cm.ListConfigs(context.Background(), Caller{UserID: "u"}, "", prefix, cursor, limit)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var page []string
+		for _, file := range files {
+			page = append(page, file.Filename)
+		}
+		pages = append(pages, page)
+		if next == "" {
+			return pages
+		}
+		if len(pages) > 100 {
+			t.Fatal("listing does not end")
+		}
+		cursor = next
+	}
+}
+
+func TestListConfigsPages(t *testing.T) {
+	ctx := context.Background()
+	cm := NewConfigManager(NewFileStore(t.TempDir()))
+	for _, filename := range []string{"a.json", "b.json", "c.json", "dir/a.json", "dir/b.json", "dir/c.json", "dirt.json"} {
+		if _, err := cm.AddConfig(ctx, Caller{UserID: "u"}, "", filename, FileTypeUnspecified, []byte(`{}`)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		prefix string
+		limit  int
+		want   [][]string
+	}{
+		{limit: 0, want: [][]string{{"a.json", "b.json", "c.json", "dir/a.json", "dir/b.json", "dir/c.json", "dirt.json"}}},
+		{limit: 3, want: [][]string{{"a.json", "b.json", "c.json"}, {"dir/a.json", "dir/b.json", "dir/c.json"}, {"dirt.json"}}},
+		// A last page that is exactly full has no next cursor
+		{limit: 7, want: [][]string{{"a.json", "b.json", "c.json", "dir/a.json", "dir/b.json", "dir/c.json", "dirt.json"}}},
+		{limit: maxListLimit + 1, want: [][]string{{"a.json", "b.json", "c.json", "dir/a.json", "dir/b.json", "dir/c.json", "dirt.json"}}},
+		{prefix: "dir/", limit: 2, want: [][]string{{"dir/a.json", "dir/b.json"}, {"dir/c.json"}}},
+		{prefix: "dir/", limit: 3, want: [][]string{{"dir/a.json", "dir/b.json", "dir/c.json"}}},
+		{prefix: "dir", limit: 2, want: [][]string{{"dir/a.json", "dir/b.json"}, {"dir/c.json", "dirt.json"}}},
+		{prefix: "none/", limit: 2, want: [][]string{nil}},
+	}
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("prefix %q limit %d", test.prefix, test.limit), func(t *testing.T) {
+			pages := listAll(t, cm, test.prefix, test.limit)
+			if !slices.EqualFunc(pages, test.want, slices.Equal[[]string]) {
+				t.Errorf("pages = %q, want %q", pages, test.want)
+			}
+		})
+	}
+}
+
+func TestListConfigsCursor(t *testing.T) {
+	ctx := context.Background()
+	cm := NewConfigManager(NewFileStore(t.TempDir()))
+	caller := Caller{UserID: "u"}
+	for _, filename := range []string{"a.json", "b.json", "c.json", "d.json"} {
+		if _, err := cm.AddConfig(ctx, caller, "", filename, FileTypeUnspecified, []byte(`{}`)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, _, err := cm.ListConfigs(ctx, caller, "", "", "not a cursor!", 2); !errors.Is(err, ErrInvalidCursor) {
+		t.Errorf("ListConfigs with an invalid cursor = %v, want %v", err, ErrInvalidCursor)
+	}
+
+	files, next, err := cm.ListConfigs(ctx, caller, "", "", "", 2)
+	if err != nil || len(files) != 2 || next == "" {
+		t.Fatalf("first page = %d files, %q, %v", len(files), next, err)
+	}
+
+	// A cursor stays valid when its file is deleted and files are added
+	// before and after it
+	if err := cm.DeleteConfig(ctx, caller, "", "b.json", Precondition{}); err != nil {
+		t.Fatal(err)
+	}
+	for _, filename := range []string{"a0.json", "e.json"} {
+		if _, err := cm.AddConfig(ctx, caller, "", filename, FileTypeUnspecified, []byte(`{}`)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	files, next, err = cm.ListConfigs(ctx, caller, "", "", next, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 2 || files[0].Filename != "c.json" || files[1].Filename != "d.json" || next == "" {
+		t.Errorf("second page = %v, %q; want c.json, d.json and a cursor", files, next)
+	}
+	files, next, err = cm.ListConfigs(ctx, caller, "", "", next, 2)
+	if err != nil || len(files) != 1 || files[0].Filename != "e.json" || next != "" {
+		t.Errorf("last page = %v, %q, %v; want e.json", files, next, err)
+	}
+}
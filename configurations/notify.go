@@ -0,0 +1,35 @@
+package configurations
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrNotifyUnsupported is returned by FollowStore for stores that cannot
+// report changes
+var ErrNotifyUnsupported = errors.New("store does not support change notifications")
+
+// Notifier is implemented by stores that can report the changes made by
+// every process sharing the storage, not only those made through the local
+// ConfigManager
+type Notifier interface {
+	// Notify calls publish with an event for every change of the stored
+	// files until ctx is done or the feed fails for good. It calls ready
+	// once every change made from then on is going to be published.
+	Notify(ctx context.Context, ready func(), publish func(*Event)) error
+}
+
+// FollowStore feeds watchers from the change notifications of the store
+// until ctx is done, so they see the changes made by every replica sharing
+// the store. While it runs the manager stops publishing its own changes,
+// which the store reports as well. Before it is ready and after it returned
+// watchers only see the changes made through this manager.
+func (cm *ConfigManager) FollowStore(ctx context.Context) error {
+	notifier, ok := cm.store.(Notifier)
+	if !ok {
+		return ErrNotifyUnsupported
+	}
+
+	defer cm.following.Store(false)
+	return notifier.Notify(ctx, func() { cm.following.Store(true) }, cm.bus.Publish)
+}
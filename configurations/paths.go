@@ -0,0 +1,131 @@
+package configurations
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidPath is returned when a user ID, filename or directory is not a
+// valid configuration path
+var ErrInvalidPath = errors.New("invalid path")
+
+const (
+	// maxPathLength is the longest filename or directory path accepted
+	maxPathLength = 1024
+	// maxSegmentLength is the longest single path segment accepted, matching
+	// the file name limit of common filesystems
+	maxSegmentLength = 255
+	// maxUserIDLength is the longest user ID accepted as a namespace
+	maxUserIDLength = 128
+)
+
+// validatePath checks that p is a relative slash separated path of non-empty
+// segments made of letters, digits and the characters "._-@". Dot segments
+// are rejected rather than resolved so a path can never address anything
+// outside the namespace of its user.
+func validatePath(p string) error {
+	if p == "" {
+		return fmt.Errorf("%w: path is empty", ErrInvalidPath)
+	}
+	if len(p) > maxPathLength {
+		return fmt.Errorf("%w: path is longer than %d bytes", ErrInvalidPath, maxPathLength)
+	}
+	if strings.HasPrefix(p, "/") {
+		return fmt.Errorf("%w: absolute paths are not allowed", ErrInvalidPath)
+	}
+
+	for _, segment := range strings.Split(p, "/") {
+		switch segment {
+		case "":
+			return fmt.Errorf("%w: path contains an empty segment", ErrInvalidPath)
+		case ".", "..":
+			return fmt.Errorf("%w: %q segments are not allowed", ErrInvalidPath, segment)
+		}
+		if len(segment) > maxSegmentLength {
+			return fmt.Errorf("%w: path segment is longer than %d bytes", ErrInvalidPath, maxSegmentLength)
+		}
+		if err := checkPathChars(segment); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateUserID checks that a user ID can namespace configuration files. It
+// must be a single path segment and may not start with a dot, which keeps it
+// apart from the bookkeeping directories of FileStore. Team namespaces pass
+// as well; users.UserManager applies it to new user IDs.
+func ValidateUserID(userID string) error {
+	if userID == "" {
+		return fmt.Errorf("%w: user ID is empty", ErrInvalidPath)
+	}
+	if len(userID) > maxUserIDLength {
+		return fmt.Errorf("%w: user ID is longer than %d bytes", ErrInvalidPath, maxUserIDLength)
+	}
+	if strings.HasPrefix(userID, ".") {
+		return fmt.Errorf("%w: user ID must not start with a dot", ErrInvalidPath)
+	}
+	return checkPathChars(userID)
+}
+
+// validateFile checks the user ID and filename addressing a configuration file
+func validateFile(userID, filename string) error {
+	if err := ValidateUserID(userID); err != nil {
+		return err
+	}
+	return validatePath(filename)
+}
+
+// validateDir checks a directory path and strips a trailing slash from it.
+// The empty path is the root directory.
+func validateDir(dir string) (string, error) {
+	dir = strings.TrimSuffix(dir, "/")
+	if dir == "" {
+		return "", nil
+	}
+	return dir, validatePath(dir)
+}
+
+// validatePrefix checks a listing prefix, which may end in a partial segment
+func validatePrefix(prefix string) error {
+	if len(prefix) > maxPathLength {
+		return fmt.Errorf("%w: prefix is longer than %d bytes", ErrInvalidPath, maxPathLength)
+	}
+	if strings.HasPrefix(prefix, "/") {
+		return fmt.Errorf("%w: absolute paths are not allowed", ErrInvalidPath)
+	}
+	return checkPathChars(strings.ReplaceAll(prefix, "/", ""))
+}
+
+// checkPathChars rejects any character outside the allowed path alphabet
+func checkPathChars(s string) error {
+	for _, c := range s {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		case c == '.', c == '_', c == '-', c == '@':
+		default:
+			return fmt.Errorf("%w: character %q is not allowed", ErrInvalidPath, c)
+		}
+	}
+	return nil
+}
+
+// parentDirs returns the directories containing a path, outermost first
+func parentDirs(p string) []string {
+	var dirs []string
+	for i, c := range p {
+		if c == '/' {
+			dirs = append(dirs, p[:i])
+		}
+	}
+	return dirs
+}
+
+// dirPrefix returns the prefix shared by every path below dir
+func dirPrefix(dir string) string {
+	if dir == "" {
+		return ""
+	}
+	return dir + "/"
+}
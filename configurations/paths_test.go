@@ -0,0 +1,146 @@
+package configurations
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidatePath(t *testing.T) {
+	tests := []struct {
+		name  string
+		path  string
+		valid bool
+	}{
+		{name: "file", path: "app.yaml", valid: true},
+		{name: "nested file", path: "services/db/app.yaml", valid: true},
+		{name: "allowed characters", path: "a-b_c.d@e/F9", valid: true},
+		{name: "leading dot in a segment", path: "dir/.env", valid: true},
+		{name: "dots inside a segment", path: "a..b", valid: true},
+		{name: "longest segment", path: strings.Repeat("a", maxSegmentLength), valid: true},
+		{name: "longest path", path: strings.Repeat(strings.Repeat("a", 99)+"/", 10) + strings.Repeat("a", 24), valid: true},
+
+		{name: "empty", path: ""},
+		{name: "absolute", path: "/etc/passwd"},
+		{name: "parent segment", path: "../other/app.yaml"},
+		{name: "parent segment inside", path: "a/../../b"},
+		{name: "parent segment at the end", path: "a/.."},
+		{name: "current segment", path: "./app.yaml"},
+		{name: "current segment inside", path: "a/./b"},
+		{name: "empty segment", path: "a//b"},
+		{name: "trailing slash", path: "dir/"},
+		{name: "segment too long", path: strings.Repeat("a", maxSegmentLength+1)},
+		{name: "path too long", path: strings.Repeat(strings.Repeat("a", 99)+"/", 10) + strings.Repeat("a", 25)},
+		{name: "space", path: "my app.yaml"},
+		{name: "backslash", path: `a\b`},
+		{name: "colon", path: "c:app"},
+		{name: "non-ASCII letter", path: "café.yaml"},
+		{name: "NUL byte", path: "a\x00b"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validatePath(test.path)
+			if test.valid {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, ErrInvalidPath) {
+				t.Errorf("error %v does not wrap ErrInvalidPath", err)
+			}
+		})
+	}
+}
+
+func TestValidatePrefix(t *testing.T) {
+	tests := []struct {
+		name   string
+		prefix string
+		valid  bool
+	}{
+		{name: "empty", prefix: "", valid: true},
+		{name: "partial segment", prefix: "serv", valid: true},
+		{name: "directory", prefix: "services/", valid: true},
+		{name: "partial nested segment", prefix: "services/d", valid: true},
+		{name: "longest prefix", prefix: strings.Repeat("a", maxPathLength), valid: true},
+
+		{name: "absolute", prefix: "/services"},
+		{name: "too long", prefix: strings.Repeat("a", maxPathLength+1)},
+		{name: "space", prefix: "my services"},
+		{name: "wildcard", prefix: "services/*"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validatePrefix(test.prefix)
+			if test.valid {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, ErrInvalidPath) {
+				t.Errorf("error %v does not wrap ErrInvalidPath", err)
+			}
+		})
+	}
+}
+
+func TestValidateUserID(t *testing.T) {
+	tests := []struct {
+		name   string
+		userID string
+		valid  bool
+	}{
+		{name: "user", userID: "alice", valid: true},
+		{name: "allowed characters", userID: "a.b_c-d@e9", valid: true},
+		// Team namespaces start with "@"; users.UserManager keeps users from
+		// taking such IDs
+		{name: "team namespace", userID: "@platform", valid: true},
+		{name: "longest", userID: strings.Repeat("a", maxUserIDLength), valid: true},
+
+		{name: "empty", userID: ""},
+		{name: "too long", userID: strings.Repeat("a", maxUserIDLength+1)},
+		{name: "leading dot", userID: ".history"},
+		{name: "dot", userID: "."},
+		{name: "parent", userID: ".."},
+		{name: "slash", userID: "alice/bob"},
+		{name: "space", userID: "alice smith"},
+		{name: "colon", userID: "team:ops"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateUserID(test.userID)
+			if test.valid {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, ErrInvalidPath) {
+				t.Errorf("error %v does not wrap ErrInvalidPath", err)
+			}
+		})
+	}
+}
+
+func TestValidateDir(t *testing.T) {
+	tests := []struct {
+		dir     string
+		want    string
+		wantErr bool
+	}{
+		{dir: "", want: ""},
+		{dir: "/", want: ""},
+		{dir: "services", want: "services"},
+		{dir: "services/db/", want: "services/db"},
+		{dir: "services//", wantErr: true},
+		{dir: "../services", wantErr: true},
+	}
+	for _, test := range tests {
+		got, err := validateDir(test.dir)
+		if (err != nil) != test.wantErr || (err == nil && got != test.want) {
+			t.Errorf("validateDir(%q) = %q, %v; want %q, error %v", test.dir, got, err, test.want, test.wantErr)
+		}
+	}
+}
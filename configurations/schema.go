@@ -0,0 +1,254 @@
+package configurations
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+var (
+	// ErrInvalidSchema is returned for schemas that do not compile and for
+	// invalid schema patterns
+	ErrInvalidSchema = errors.New("invalid schema")
+	// ErrSchemaNotFound is returned when no schema is registered for a
+	// pattern
+	ErrSchemaNotFound = errors.New("schema not found")
+	// ErrSchemaViolation is returned, wrapped in a SchemaError, when content
+	// does not validate against the schemas of its file
+	ErrSchemaViolation = errors.New("content violates its schema")
+)
+
+// Schema is a JSON Schema registered for the structured files of a
+// namespace whose names match Pattern
+type Schema struct {
+	// Pattern is a filename or a glob in which "*", "?" and character
+	// classes match within a path segment and a "**" segment matches any
+	// number of segments
+	Pattern   string          `json:"pattern"`
+	Schema    json.RawMessage `json:"schema"`
+	Author    string          `json:"author"`
+	UpdatedAt time.Time       `json:"updated_at"`
+}
+
+// SchemaViolation is a single reason content does not validate against a
+// schema
+type SchemaViolation struct {
+	// Schema is the pattern of the violated schema
+	Schema string `json:"schema"`
+	// Path is the JSON pointer of the offending value, "/" for the document
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+// SchemaError lists every violation of the schemas of a file. It matches
+// ErrSchemaViolation with errors.Is.
+type SchemaError struct {
+	Violations []SchemaViolation `json:"violations"`
+}
+
+func (e *SchemaError) Error() string {
+	messages := make([]string, 0, len(e.Violations))
+	for _, violation := range e.Violations {
+		messages = append(messages, fmt.Sprintf("%s: %s", violation.Path, violation.Message))
+	}
+	return fmt.Sprintf("%s: %s", ErrSchemaViolation, strings.Join(messages, "; "))
+}
+
+func (e *SchemaError) Unwrap() error {
+	return ErrSchemaViolation
+}
+
+// SetSchema registers a JSON Schema for the files of a namespace matching
+// pattern, replacing the schema registered for the same pattern. Files
+// already stored are only validated when they are written next.
+func (cm *ConfigManager) SetSchema(ctx context.Context, caller Caller, namespace, pattern string, schema []byte) (*Schema, error) {
+	namespace, err := authorizeNamespace(caller, namespace)
+	if err != nil {
+		return nil, err
+	}
+	if err := validatePattern(pattern); err != nil {
+		return nil, err
+	}
+	if _, err := compileSchema(schema); err != nil {
+		return nil, err
+	}
+
+	registered := &Schema{
+		Pattern:   pattern,
+		Schema:    schema,
+		Author:    caller.UserID,
+		UpdatedAt: time.Now(),
+	}
+	if err := cm.store.PutSchema(ctx, namespace, registered); err != nil {
+		return nil, err
+	}
+	return registered, nil
+}
+
+// DeleteSchema removes the schema registered for pattern
+func (cm *ConfigManager) DeleteSchema(ctx context.Context, caller Caller, namespace, pattern string) error {
+	namespace, err := authorizeNamespace(caller, namespace)
+	if err != nil {
+		return err
+	}
+	return cm.store.DeleteSchema(ctx, namespace, pattern)
+}
+
+// ListSchemas returns the schemas registered in a namespace of the caller
+func (cm *ConfigManager) ListSchemas(ctx context.Context, caller Caller, namespace string) ([]*Schema, error) {
+	namespace, err := authorizeNamespace(caller, namespace)
+	if err != nil {
+		return nil, err
+	}
+	return cm.store.Schemas(ctx, namespace)
+}
+
+// validateSchemas validates structured content against every schema whose
+// pattern matches the file and returns a SchemaError listing all violations
+func (cm *ConfigManager) validateSchemas(ctx context.Context, namespace, filename string, fileType FileType, data []byte) error {
+	if !fileType.structured() {
+		return nil
+	}
+
+	schemas, err := cm.store.Schemas(ctx, namespace)
+	if err != nil {
+		return err
+	}
+	var matching []*Schema
+	for _, schema := range schemas {
+		if matchPattern(schema.Pattern, filename) {
+			matching = append(matching, schema)
+		}
+	}
+	if len(matching) == 0 {
+		return nil
+	}
+
+	doc, err := schemaInstance(fileType, data)
+	if errors.Is(err, ErrUnsupportedConversion) {
+		// HCL referencing variables has no values to validate
+		return &SchemaError{Violations: []SchemaViolation{{Schema: matching[0].Pattern, Path: "/", Message: err.Error()}}}
+	}
+	if err != nil {
+		return err
+	}
+
+	var violations []SchemaViolation
+	for _, schema := range matching {
+		compiled, err := compileSchema(schema.Schema)
+		if err != nil {
+			return fmt.Errorf("schema %s: %w", schema.Pattern, err)
+		}
+
+		err = compiled.Validate(doc)
+		var validationErr *jsonschema.ValidationError
+		if errors.As(err, &validationErr) {
+			violations = appendViolations(violations, schema.Pattern, validationErr)
+		} else if err != nil {
+			return err
+		}
+	}
+	if len(violations) > 0 {
+		return &SchemaError{Violations: violations}
+	}
+	return nil
+}
+
+// schemaURL identifies a schema while it is compiled. Relative file URLs
+// would resolve against the working directory of the server.
+const schemaURL = "mem:///schema.json"
+
+// compileSchema compiles a JSON Schema document. References to other
+// documents are rejected so schemas cannot read files or URLs through the
+// server.
+func compileSchema(schema []byte) (*jsonschema.Schema, error) {
+	compiler := jsonschema.NewCompiler()
+	compiler.LoadURL = func(url string) (io.ReadCloser, error) {
+		return nil, fmt.Errorf("cannot load %s", url)
+	}
+
+	if err := compiler.AddResource(schemaURL, bytes.NewReader(schema)); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSchema, err)
+	}
+	compiled, err := compiler.Compile(schemaURL)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSchema, err)
+	}
+	return compiled, nil
+}
+
+// schemaInstance decodes content into the values the schema validator
+// expects. Empty content is validated as null.
+func schemaInstance(fileType FileType, data []byte) (interface{}, error) {
+	doc, err := decodeDocument(fileType, data)
+	if err != nil || doc == nil {
+		return nil, err
+	}
+	return jsonValues(doc, true)
+}
+
+// appendViolations adds the innermost causes of a validation error, which
+// name the offending values
+func appendViolations(violations []SchemaViolation, pattern string, err *jsonschema.ValidationError) []SchemaViolation {
+	if len(err.Causes) == 0 {
+		location := err.InstanceLocation
+		if location == "" {
+			location = "/"
+		}
+		return append(violations, SchemaViolation{Schema: pattern, Path: location, Message: err.Message})
+	}
+	for _, cause := range err.Causes {
+		violations = appendViolations(violations, pattern, cause)
+	}
+	return violations
+}
+
+// validatePattern checks the syntax of a schema pattern
+func validatePattern(pattern string) error {
+	if pattern == "" || strings.HasPrefix(pattern, "/") || strings.HasSuffix(pattern, "/") {
+		return fmt.Errorf("%w: pattern must be a relative path", ErrInvalidSchema)
+	}
+	for _, segment := range strings.Split(pattern, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return fmt.Errorf("%w: pattern %q has an invalid segment", ErrInvalidSchema, pattern)
+		}
+		if _, err := path.Match(segment, ""); err != nil {
+			return fmt.Errorf("%w: pattern %q: %v", ErrInvalidSchema, pattern, err)
+		}
+	}
+	return nil
+}
+
+// matchPattern reports whether filename matches a schema pattern
+func matchPattern(pattern, filename string) bool {
+	return matchSegments(strings.Split(pattern, "/"), strings.Split(filename, "/"))
+}
+
+func matchSegments(pattern, name []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for i := 0; i <= len(name); i++ {
+				if matchSegments(pattern[1:], name[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(name) == 0 {
+			return false
+		}
+		if ok, _ := path.Match(pattern[0], name[0]); !ok {
+			return false
+		}
+		pattern, name = pattern[1:], name[1:]
+	}
+	return len(name) == 0
+}
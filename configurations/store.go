@@ -0,0 +1,123 @@
+package configurations
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+var (
+	// ErrFileExists is returned when creating a file that is already stored
+	ErrFileExists = errors.New("file already exists")
+	// ErrFileNotFound is returned when a file does not exist in the store
+	ErrFileNotFound = errors.New("file not found")
+	// ErrRevisionNotFound is returned when a file has no such revision
+	ErrRevisionNotFound = errors.New("revision not found")
+	// ErrPreconditionFailed is returned when a write is based on a revision
+	// that is no longer the latest one
+	ErrPreconditionFailed = errors.New("precondition failed")
+	// ErrInvalidCursor is returned when a listing cursor cannot be decoded
+	ErrInvalidCursor = errors.New("invalid cursor")
+)
+
+// Precondition guards a write against concurrent modifications of the same
+// file. Zero fields are not checked.
+type Precondition struct {
+	// Revision is the revision the file must currently be at
+	Revision int
+	// ETags lists content hashes, one of which the latest revision must have
+	ETags []string
+	// NotExists requires that the file does not exist yet
+	NotExists bool
+}
+
+// check verifies the precondition against the latest revision of a file,
+// which is nil when the file does not exist
+func (p Precondition) check(current *ConfigFile) error {
+	if current == nil {
+		if p.Revision != 0 || len(p.ETags) > 0 {
+			return fmt.Errorf("%w: file does not exist", ErrPreconditionFailed)
+		}
+		return nil
+	}
+
+	if p.NotExists {
+		return ErrFileExists
+	}
+	if p.Revision != 0 && current.Revision != p.Revision {
+		return fmt.Errorf("%w: file is at revision %d", ErrPreconditionFailed, current.Revision)
+	}
+	if len(p.ETags) > 0 && !slices.Contains(p.ETags, current.Hash) {
+		return fmt.Errorf("%w: file content has changed", ErrPreconditionFailed)
+	}
+	return nil
+}
+
+// ListOptions filters and paginates a listing of files
+type ListOptions struct {
+	// Prefix restricts the listing to filenames starting with it
+	Prefix string
+	// After resumes the listing with the first filename sorting after it
+	After string
+	// Limit caps the number of files returned; zero means no limit
+	Limit int
+}
+
+// matches reports whether filename passes the prefix and After filters
+func (o ListOptions) matches(filename string) bool {
+	return strings.HasPrefix(filename, o.Prefix) && filename > o.After
+}
+
+// Store is a storage backend for configuration files. Files are namespaced
+// by the ID of the user that owns them and every write is kept as a new
+// immutable revision.
+type Store interface {
+	// Put writes data as the next revision of file if cond holds. The
+	// caller sets the author and rollback source of rev; the store assigns
+	// the remaining fields.
+	Put(ctx context.Context, file *ConfigFile, rev *Revision, data []byte, cond Precondition) error
+	// Get returns the content and metadata of the latest revision of a file.
+	Get(ctx context.Context, userID, filename string) ([]byte, *ConfigFile, error)
+	// Delete removes a file together with its revision history if cond
+	// holds.
+	Delete(ctx context.Context, userID, filename string, cond Precondition) error
+	// Rename moves a file and its revision history to a new filename if
+	// cond holds for the file. It fails with ErrFileExists when the new
+	// filename is taken.
+	Rename(ctx context.Context, userID, from, to string, cond Precondition) error
+	// List returns the metadata of the files owned by a user that match
+	// opts, ordered by filename.
+	List(ctx context.Context, userID string, opts ListOptions) ([]*ConfigFile, error)
+	// Stat returns the metadata of a file without reading its content.
+	Stat(ctx context.Context, userID, filename string) (*ConfigFile, error)
+	// Revisions returns the revision history of a file, oldest first.
+	Revisions(ctx context.Context, userID, filename string) ([]*Revision, error)
+	// GetRevision returns the content of a specific revision of a file.
+	GetRevision(ctx context.Context, userID, filename string, number int) ([]byte, *Revision, error)
+	// Grants returns the access grants of a file. Grants follow a file
+	// when it is renamed and are removed together with it.
+	Grants(ctx context.Context, userID, filename string) ([]Grant, error)
+	// SetGrant adds or replaces the grant of a file to grant.Grantee, or
+	// removes it when grant.Access is AccessNone.
+	SetGrant(ctx context.Context, userID, filename string, grant Grant) error
+	// RevokeGrantee removes the grants to grantee from every file.
+	RevokeGrantee(ctx context.Context, grantee string) error
+	// Schemas returns the schemas registered in a namespace ordered by
+	// pattern.
+	Schemas(ctx context.Context, userID string) ([]*Schema, error)
+	// PutSchema adds or replaces the schema registered for schema.Pattern.
+	PutSchema(ctx context.Context, userID string, schema *Schema) error
+	// DeleteSchema removes the schema registered for pattern. It fails with
+	// ErrSchemaNotFound when there is none.
+	DeleteSchema(ctx context.Context, userID, pattern string) error
+}
+
+// contentHash returns the hex encoded SHA-256 digest of data
+func contentHash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
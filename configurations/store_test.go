@@ -0,0 +1,44 @@
+package configurations
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPreconditionCheck(t *testing.T) {
+	current := &ConfigFile{Filename: "app.json", Revision: 3, Hash: "h3"}
+	tests := []struct {
+		name    string
+		cond    Precondition
+		current *ConfigFile
+		want    error
+	}{
+		{name: "none", current: current},
+		{name: "none on a missing file"},
+		{name: "revision", cond: Precondition{Revision: 3}, current: current},
+		{name: "old revision", cond: Precondition{Revision: 2}, current: current, want: ErrPreconditionFailed},
+		{name: "etag", cond: Precondition{ETags: []string{"h3"}}, current: current},
+		{name: "changed etag", cond: Precondition{ETags: []string{"h2"}}, current: current, want: ErrPreconditionFailed},
+		{name: "one of several etags", cond: Precondition{ETags: []string{"h2", "h3"}}, current: current},
+		{name: "none of several etags", cond: Precondition{ETags: []string{"h1", "h2"}}, current: current, want: ErrPreconditionFailed},
+		{name: "revision and changed etag", cond: Precondition{Revision: 3, ETags: []string{"h2"}}, current: current, want: ErrPreconditionFailed},
+		{name: "revision of a missing file", cond: Precondition{Revision: 3}, want: ErrPreconditionFailed},
+		{name: "etag of a missing file", cond: Precondition{ETags: []string{"h3"}}, want: ErrPreconditionFailed},
+		{name: "not exists", cond: Precondition{NotExists: true}},
+		{name: "not exists on an existing file", cond: Precondition{NotExists: true}, current: current, want: ErrFileExists},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.cond.check(test.current)
+			if test.want == nil {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, test.want) {
+				t.Errorf("got %v, want %v", err, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package configurations
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"unicode"
+
+	"gopkg.in/yaml.v2"
+)
+
+// ValidationError locates the first problem in content that does not parse
+// as its file type. It matches ErrFileTypeMismatch with errors.Is.
+type ValidationError struct {
+	FileType FileType `json:"file_type"`
+	// Line and Column are 1-based; zero when the parser does not report
+	// them
+	Line    int    `json:"line,omitempty"`
+	Column  int    `json:"column,omitempty"`
+	Message string `json:"message"`
+}
+
+func (e *ValidationError) Error() string {
+	switch {
+	case e.Line > 0 && e.Column > 0:
+		return fmt.Sprintf("invalid %s at line %d, column %d: %s", e.FileType, e.Line, e.Column, e.Message)
+	case e.Line > 0:
+		return fmt.Sprintf("invalid %s at line %d: %s", e.FileType, e.Line, e.Message)
+	default:
+		return fmt.Sprintf("invalid %s: %s", e.FileType, e.Message)
+	}
+}
+
+func (e *ValidationError) Unwrap() error {
+	return ErrFileTypeMismatch
+}
+
+// Validate returns a ValidationError unless data parses as t. JSON must be
+// a single well-formed value, YAML, XML, TOML and HCL well-formed documents
+// and CSV records must all have the same number of fields. INI, properties
+// and .env files must consist of keys and values. HCL is not evaluated, so
+// it may reference variables. Empty content and text are always valid.
+func (t FileType) Validate(data []byte) error {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil
+	}
+
+	var err *ValidationError
+	switch t {
+	case FileTypeJSON:
+		err = validateJSON(data)
+	case FileTypeYAML:
+		err = validateYAML(data)
+	case FileTypeXML:
+		err = validateXML(data)
+	case FileTypeCSV:
+		err = validateCSV(data)
+	case FileTypeTOML:
+		_, err = decodeTOML(data)
+	case FileTypeHCL:
+		_, err = parseHCL(data)
+	case FileTypeINI:
+		_, err = decodeINI(data)
+	case FileTypeProperties:
+		_, err = decodeProperties(data)
+	case FileTypeEnv:
+		_, err = decodeEnv(data)
+	}
+	if err != nil {
+		err.FileType = t
+		return err
+	}
+	return nil
+}
+
+func validateJSON(data []byte) *ValidationError {
+	var doc interface{}
+	err := json.Unmarshal(data, &doc)
+	if err == nil {
+		return nil
+	}
+
+	var syntaxErr *json.SyntaxError
+	if errors.As(err, &syntaxErr) {
+		// The offset is just past the offending byte
+		line, column := position(data, syntaxErr.Offset-1)
+		return &ValidationError{Line: line, Column: column, Message: syntaxErr.Error()}
+	}
+	return &ValidationError{Message: err.Error()}
+}
+
+// yamlLinePattern extracts the line from the errors of the YAML parser,
+// which reports no column
+var yamlLinePattern = regexp.MustCompile(`^yaml: line (\d+): (.*)$`)
+
+func validateYAML(data []byte) *ValidationError {
+	var doc interface{}
+	err := yaml.Unmarshal(data, &doc)
+	if err == nil {
+		return nil
+	}
+
+	if match := yamlLinePattern.FindStringSubmatch(err.Error()); match != nil {
+		line, _ := strconv.Atoi(match[1])
+		return &ValidationError{Line: line, Message: match[2]}
+	}
+	return &ValidationError{Message: err.Error()}
+}
+
+// validateXML checks that data is well-formed XML with a single root
+// element. Only whitespace, comments and processing instructions may
+// follow the root element.
+func validateXML(data []byte) *ValidationError {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	root := false
+	depth := 0
+	for {
+		offset := decoder.InputOffset()
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			var syntaxErr *xml.SyntaxError
+			if !errors.As(err, &syntaxErr) {
+				return &ValidationError{Message: err.Error()}
+			}
+			line, column := position(data, decoder.InputOffset()-1)
+			if line != syntaxErr.Line {
+				line, column = syntaxErr.Line, 0
+			}
+			return &ValidationError{Line: line, Column: column, Message: syntaxErr.Msg}
+		}
+
+		// The decoder accepts any number of root elements
+		switch token := token.(type) {
+		case xml.StartElement:
+			if root && depth == 0 {
+				line, column := position(data, offset)
+				return &ValidationError{Line: line, Column: column, Message: "element after the root element"}
+			}
+			root = true
+			depth++
+		case xml.EndElement:
+			depth--
+		case xml.CharData:
+			text := bytes.TrimLeftFunc(token, unicode.IsSpace)
+			if root && depth == 0 && len(text) > 0 {
+				line, column := position(data, offset+int64(len(token)-len(text)))
+				return &ValidationError{Line: line, Column: column, Message: "text after the root element"}
+			}
+		case xml.Directive:
+			if root && depth == 0 {
+				line, column := position(data, offset)
+				return &ValidationError{Line: line, Column: column, Message: "directive after the root element"}
+			}
+		}
+	}
+	if !root {
+		return &ValidationError{Message: "no root element"}
+	}
+	return nil
+}
+
+func validateCSV(data []byte) *ValidationError {
+	_, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+	if err == nil {
+		return nil
+	}
+
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return &ValidationError{Line: parseErr.Line, Column: parseErr.Column, Message: parseErr.Err.Error()}
+	}
+	return &ValidationError{Message: err.Error()}
+}
+
+// position converts a byte offset into a 1-based line and column
+func position(data []byte, offset int64) (line, column int) {
+	if offset < 0 {
+		offset = 0
+	}
+	if offset > int64(len(data)) {
+		offset = int64(len(data))
+	}
+	before := data[:offset]
+	line = bytes.Count(before, []byte("\n")) + 1
+	column = int(offset) - (bytes.LastIndexByte(before, '\n') + 1) + 1
+	return line, column
+}
@@ -0,0 +1,126 @@
+package configurations
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		fileType FileType
+		data     string
+		valid    bool
+		line     int
+		column   int
+	}{
+		{name: "empty JSON", fileType: FileTypeJSON, data: "", valid: true},
+		{name: "blank YAML", fileType: FileTypeYAML, data: "\n  \n", valid: true},
+		{name: "text", fileType: FileTypeTXT, data: "{ anything", valid: true},
+		{name: "unknown type", fileType: FileTypeUnspecified, data: "{", valid: true},
+
+		{name: "JSON", fileType: FileTypeJSON, data: `{"a": [1, 2]}`, valid: true},
+		{name: "JSON missing comma", fileType: FileTypeJSON, data: "{\n  \"a\": 1\n  \"b\": 2\n}", line: 3, column: 3},
+		{name: "JSON trailing data", fileType: FileTypeJSON, data: "{}\n{}", line: 2, column: 1},
+		{name: "JSON truncated", fileType: FileTypeJSON, data: "{\"a\": ", line: 1, column: 6},
+
+		{name: "YAML", fileType: FileTypeYAML, data: "a:\n  - 1\n", valid: true},
+		{name: "YAML bad indentation", fileType: FileTypeYAML, data: "a: 1\n b: 2\nc: 3\n", line: 2},
+		{name: "YAML tab indentation", fileType: FileTypeYAML, data: "a:\n\t- 1\n", line: 2},
+
+		{name: "XML", fileType: FileTypeXML, data: "<?xml version=\"1.0\"?>\n<a><b/></a>", valid: true},
+		{name: "XML mismatched tag", fileType: FileTypeXML, data: "<a>\n  <b></c>\n</a>", line: 2, column: 9},
+		{name: "XML unclosed element", fileType: FileTypeXML, data: "<a>\n<b>\n", line: 3},
+		{name: "XML without root", fileType: FileTypeXML, data: "<!-- comment -->"},
+		{name: "XML comment after root", fileType: FileTypeXML, data: "<a/>\n<!-- comment -->\n<?pi x?>\n", valid: true},
+		{name: "XML second root", fileType: FileTypeXML, data: "<a/>\n  <b/>", line: 2, column: 3},
+		{name: "XML text after root", fileType: FileTypeXML, data: "<a><b/></a>\n\n x", line: 3, column: 2},
+		{name: "XML directive after root", fileType: FileTypeXML, data: "<a/><!DOCTYPE a>", line: 1, column: 5},
+
+		{name: "CSV", fileType: FileTypeCSV, data: "a,b\n1,2\n", valid: true},
+		{name: "CSV field count", fileType: FileTypeCSV, data: "a,b\n1,2\n3\n", line: 3, column: 1},
+		{name: "CSV bare quote", fileType: FileTypeCSV, data: "a,b\n1,x\"y\n", line: 2, column: 4},
+
+		{name: "TOML", fileType: FileTypeTOML, data: "[a]\nb = 1\n", valid: true},
+		{name: "TOML missing value", fileType: FileTypeTOML, data: "a = 1\nb = \n", line: 2, column: 5},
+		// go-toml reports no position for duplicate keys
+		{name: "TOML duplicate key", fileType: FileTypeTOML, data: "a = 1\na = 2\n"},
+
+		{name: "HCL with variables", fileType: FileTypeHCL, data: "size = var.size\nblock \"x\" {\n  a = upper(\"b\")\n}\n", valid: true},
+		{name: "HCL unclosed block", fileType: FileTypeHCL, data: "block {\n  a = 1\n", line: 1, column: 7},
+		{name: "HCL missing value", fileType: FileTypeHCL, data: "a = 1\nb =\n", line: 2, column: 4},
+
+		{name: "INI", fileType: FileTypeINI, data: "[a]\nb = 1\n", valid: true},
+		{name: "INI missing separator", fileType: FileTypeINI, data: "[a]\nb = 1\n  c\n", line: 3, column: 3},
+
+		{name: "properties", fileType: FileTypeProperties, data: "a = 1\nb c\n", valid: true},
+		{name: "properties bad escape", fileType: FileTypeProperties, data: "a = 1\nb = \\u00\n", line: 2},
+
+		{name: ".env", fileType: FileTypeEnv, data: "A=1\nexport B='2'\n", valid: true},
+		{name: ".env bad name", fileType: FileTypeEnv, data: "A=1\nB.C=2\n", line: 2, column: 1},
+		{name: ".env unterminated quote", fileType: FileTypeEnv, data: "A=\"1\nB=2\n", line: 1, column: 3},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.fileType.Validate([]byte(test.data))
+			if test.valid {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, ErrFileTypeMismatch) {
+				t.Fatalf("error %v does not match ErrFileTypeMismatch", err)
+			}
+			var validationErr *ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("error %v is not a ValidationError", err)
+			}
+			if validationErr.FileType != test.fileType {
+				t.Errorf("file type = %v, want %v", validationErr.FileType, test.fileType)
+			}
+			if validationErr.Line != test.line || validationErr.Column != test.column {
+				t.Errorf("position = %d:%d, want %d:%d (%v)", validationErr.Line, validationErr.Column, test.line, test.column, err)
+			}
+		})
+	}
+}
+
+func TestValidationErrorMessage(t *testing.T) {
+	tests := []struct {
+		err  *ValidationError
+		want string
+	}{
+		{&ValidationError{FileType: FileTypeJSON, Line: 2, Column: 3, Message: "bad"}, "invalid json at line 2, column 3: bad"},
+		{&ValidationError{FileType: FileTypeYAML, Line: 2, Message: "bad"}, "invalid yaml at line 2: bad"},
+		{&ValidationError{FileType: FileTypeXML, Message: "bad"}, "invalid xml: bad"},
+	}
+	for _, test := range tests {
+		if got := test.err.Error(); got != test.want {
+			t.Errorf("Error() = %q, want %q", got, test.want)
+		}
+	}
+}
+
+func TestPosition(t *testing.T) {
+	data := []byte("ab\ncd\n\nef")
+	tests := []struct {
+		offset       int64
+		line, column int
+	}{
+		{-1, 1, 1},
+		{0, 1, 1},
+		{1, 1, 2},
+		{2, 1, 3},
+		{3, 2, 1},
+		{6, 3, 1},
+		{8, 4, 2},
+		{100, 4, 3},
+	}
+	for _, test := range tests {
+		line, column := position(data, test.offset)
+		if line != test.line || column != test.column {
+			t.Errorf("position(%d) = %d:%d, want %d:%d", test.offset, line, column, test.line, test.column)
+		}
+	}
+}
@@ -0,0 +1,180 @@
+package configurations
+
+import (
+	"context"
+	"errors"
+)
+
+// WatchOptions selects the files of a watch
+type WatchOptions struct {
+	// Filename watches a single file. Prefix is ignored when it is set.
+	Filename string
+	// Prefix watches every file whose name starts with it; the empty
+	// prefix watches the whole namespace
+	Prefix string
+	// SinceRevision resumes a file watch after the last revision the
+	// watcher has seen. Zero starts with the latest revision. Prefix
+	// watches always start with the latest revision of every file.
+	SinceRevision int
+}
+
+// Watch calls send with the changes of the files selected by opts until
+// ctx is done, send fails or the watcher falls behind. It first sends the
+// revisions the watcher has not seen yet, followed by an EventSynced event.
+// Files of other namespaces can be watched one at a time with a read grant.
+func (cm *ConfigManager) Watch(ctx context.Context, caller Caller, namespace string, opts WatchOptions, send func(*Event) error) error {
+	var err error
+	if opts.Filename != "" {
+		namespace, err = cm.authorizeFile(ctx, caller, namespace, opts.Filename, AccessRead)
+	} else {
+		namespace, err = authorizeNamespace(caller, namespace)
+		if err == nil {
+			err = validatePrefix(opts.Prefix)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	// Subscribe before reading the current state so no change is missed
+	sub := cm.bus.Subscribe(namespace, opts)
+	defer sub.Close()
+
+	// sent holds the latest revision sent for every file
+	sent := make(map[string]int)
+	if err := cm.sendCurrent(ctx, namespace, opts, sent, send); err != nil {
+		return err
+	}
+	if err := send(&Event{Type: EventSynced, Namespace: namespace}); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event, ok := <-sub.Events():
+			if !ok {
+				return ErrWatchOverflow
+			}
+
+			if event.Type == EventDeleted {
+				delete(sent, event.Filename)
+				if err := send(event); err != nil {
+					return err
+				}
+				continue
+			}
+			if event.Revision.Number <= sent[event.Filename] {
+				continue
+			}
+
+			// Grants may have been revoked since the watch started
+			if !caller.owns(namespace) {
+				if _, err := cm.authorizeFile(ctx, caller, namespace, event.Filename, AccessRead); err != nil {
+					return err
+				}
+			}
+
+			err := cm.sendRevision(ctx, namespace, event.Filename, event.Revision.Number, sent, send)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// sendCurrent sends the revisions of the watched files a watcher has not
+// seen yet
+func (cm *ConfigManager) sendCurrent(ctx context.Context, namespace string, opts WatchOptions, sent map[string]int, send func(*Event) error) error {
+	if opts.Filename == "" {
+		files, err := cm.store.List(ctx, namespace, ListOptions{Prefix: opts.Prefix})
+		if err != nil {
+			return err
+		}
+		for _, file := range files {
+			if err := cm.sendRevision(ctx, namespace, file.Filename, file.Revision, sent, send); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	revisions, err := cm.store.Revisions(ctx, namespace, opts.Filename)
+	if errors.Is(err, ErrFileNotFound) {
+		// The file may still be created
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	latest := revisions[len(revisions)-1].Number
+	if opts.SinceRevision <= 0 || opts.SinceRevision > latest {
+		// A revision newer than the latest belongs to a deleted
+		// predecessor of the file, so its whole state is sent again
+		return cm.sendRevision(ctx, namespace, opts.Filename, latest, sent, send)
+	}
+	for _, revision := range revisions {
+		if revision.Number <= opts.SinceRevision {
+			continue
+		}
+		if err := cm.sendRevision(ctx, namespace, opts.Filename, revision.Number, sent, send); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sendRevision sends a revision of a file together with its content.
+// Revisions deleted in the meantime are skipped; their deletion is sent
+// separately.
+func (cm *ConfigManager) sendRevision(ctx context.Context, namespace, filename string, number int, sent map[string]int, send func(*Event) error) error {
+	data, revision, err := cm.store.GetRevision(ctx, namespace, filename, number)
+	if errors.Is(err, ErrFileNotFound) || errors.Is(err, ErrRevisionNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	err = send(&Event{
+		Type:      EventUpdated,
+		Namespace: namespace,
+		Filename:  filename,
+		Revision:  revision,
+		Data:      data,
+	})
+	if err != nil {
+		return err
+	}
+	sent[filename] = revision.Number
+	return nil
+}
+
+// publishUpdate notifies watchers of a new revision of a file unless the
+// store reports it
+func (cm *ConfigManager) publishUpdate(namespace, filename string, revision *Revision) {
+	if cm.following.Load() {
+		return
+	}
+	cm.bus.Publish(&Event{
+		Type:      EventUpdated,
+		Namespace: namespace,
+		Filename:  filename,
+		Revision:  revision,
+	})
+}
+
+// publishDelete notifies watchers that a file no longer exists unless the
+// store reports it
+func (cm *ConfigManager) publishDelete(namespace, filename string) {
+	if cm.following.Load() {
+		return
+	}
+	cm.bus.Publish(&Event{
+		Type:      EventDeleted,
+		Namespace: namespace,
+		Filename:  filename,
+	})
+}
@@ -0,0 +1,231 @@
+package configurations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+// subscribers returns the number of subscriptions of a bus
+func subscribers(b *ChangeBus) int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.subscribers)
+}
+
+func TestChangeBusMatches(t *testing.T) {
+	tests := []struct {
+		name  string
+		opts  WatchOptions
+		event Event
+		want  bool
+	}{
+		{name: "file", opts: WatchOptions{Filename: "a.json"}, event: Event{Namespace: "u", Filename: "a.json"}, want: true},
+		{name: "other file", opts: WatchOptions{Filename: "a.json"}, event: Event{Namespace: "u", Filename: "b.json"}},
+		{name: "file ignores prefix", opts: WatchOptions{Filename: "a.json", Prefix: "dir/"}, event: Event{Namespace: "u", Filename: "dir/b.json"}},
+		{name: "prefix", opts: WatchOptions{Prefix: "dir/"}, event: Event{Namespace: "u", Filename: "dir/a.json"}, want: true},
+		{name: "outside prefix", opts: WatchOptions{Prefix: "dir/"}, event: Event{Namespace: "u", Filename: "a.json"}},
+		{name: "namespace", event: Event{Namespace: "u", Filename: "a.json"}, want: true},
+		{name: "other namespace", event: Event{Namespace: "v", Filename: "a.json"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sub := NewChangeBus().Subscribe("u", test.opts)
+			if got := sub.matches(&test.event); got != test.want {
+				t.Errorf("matches = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestChangeBusSlowSubscriber(t *testing.T) {
+	bus := NewChangeBus()
+	slow := bus.Subscribe("u", WatchOptions{})
+	fast := bus.Subscribe("u", WatchOptions{})
+
+	// Only the fast subscriber keeps up with one event more than is buffered
+	for i := 0; i <= subscriptionBuffer; i++ {
+		bus.Publish(&Event{Type: EventUpdated, Namespace: "u", Filename: "a.json", Revision: &Revision{Number: i + 1}})
+		if event := <-fast.Events(); event.Revision.Number != i+1 {
+			t.Fatalf("fast subscriber received revision %d, want %d", event.Revision.Number, i+1)
+		}
+	}
+	if n := subscribers(bus); n != 1 {
+		t.Errorf("%d subscribers left, want the fast one", n)
+	}
+
+	// The slow subscriber reads the buffered events, then the closed channel
+	buffered := 0
+	for range slow.Events() {
+		buffered++
+	}
+	if buffered != subscriptionBuffer {
+		t.Errorf("slow subscriber received %d events, want %d", buffered, subscriptionBuffer)
+	}
+
+	// Closing a dropped subscription does not close its channel again
+	slow.Close()
+	fast.Close()
+	if n := subscribers(bus); n != 0 {
+		t.Errorf("%d subscribers left after closing", n)
+	}
+}
+
+// watch runs a watch in the background and delivers its events
+func watch(ctx context.Context, cm *ConfigManager, opts WatchOptions) (<-chan *Event, <-chan error) {
+	events := make(chan *Event)
+	done := make(chan error, 1)
+	go func() {
+		done <- cm.Watch(ctx, Caller{UserID: "u"}, "", opts, func(event *Event) error {
+			select {
+			case events <- event:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+	}()
+	return events, done
+}
+
+// nextEvent waits for the next event of a watch
+func nextEvent(t *testing.T, events <-chan *Event) *Event {
+	t.Helper()
+	select {
+	case event := <-events:
+		return event
+	case <-time.After(5 * time.Second):
+		t.Fatal("no event received")
+		return nil
+	}
+}
+
+func TestWatchResume(t *testing.T) {
+	ctx := context.Background()
+	cm := NewConfigManager(NewFileStore(t.TempDir()))
+	caller := Caller{UserID: "u"}
+	if _, err := cm.AddConfig(ctx, caller, "", "app.json", FileTypeUnspecified, []byte(`{"v": 1}`)); err != nil {
+		t.Fatal(err)
+	}
+	for _, data := range []string{`{"v": 2}`, `{"v": 3}`} {
+		if _, err := cm.UpdateConfig(ctx, caller, "", "app.json", FileTypeUnspecified, []byte(data), Precondition{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		since int
+		want  []int
+	}{
+		{since: 0, want: []int{3}},
+		{since: 1, want: []int{2, 3}},
+		{since: 3},
+		// A newer revision belongs to a deleted predecessor of the file
+		{since: 5, want: []int{3}},
+	}
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("since %d", test.since), func(t *testing.T) {
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			events, done := watch(ctx, cm, WatchOptions{Filename: "app.json", SinceRevision: test.since})
+
+			for _, number := range test.want {
+				event := nextEvent(t, events)
+				if event.Type != EventUpdated || event.Revision.Number != number || string(event.Data) != fmt.Sprintf(`{"v": %d}`, number) {
+					t.Fatalf("event = %+v, want revision %d", event, number)
+				}
+			}
+			if event := nextEvent(t, events); event.Type != EventSynced {
+				t.Fatalf("event = %+v, want EventSynced", event)
+			}
+			cancel()
+			if err := <-done; !errors.Is(err, context.Canceled) {
+				t.Errorf("Watch = %v, want %v", err, context.Canceled)
+			}
+		})
+	}
+}
+
+func TestWatchUnsubscribesOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cm := NewConfigManager(NewFileStore(t.TempDir()))
+	caller := Caller{UserID: "u"}
+
+	events, done := watch(ctx, cm, WatchOptions{Prefix: "dir/"})
+	if event := nextEvent(t, events); event.Type != EventSynced {
+		t.Fatalf("event = %+v, want EventSynced", event)
+	}
+	if _, err := cm.AddConfig(ctx, caller, "", "dir/app.json", FileTypeUnspecified, []byte(`{"v": 1}`)); err != nil {
+		t.Fatal(err)
+	}
+	if event := nextEvent(t, events); event.Type != EventUpdated || event.Filename != "dir/app.json" || event.Revision.Number != 1 {
+		t.Fatalf("event = %+v, want revision 1 of dir/app.json", event)
+	}
+
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Errorf("Watch = %v, want %v", err, context.Canceled)
+	}
+	if n := subscribers(cm.bus); n != 0 {
+		t.Errorf("%d subscribers left after the watch ended", n)
+	}
+}
+
+func TestWatchSlowWatcher(t *testing.T) {
+	ctx := context.Background()
+	cm := NewConfigManager(NewFileStore(t.TempDir()))
+	caller := Caller{UserID: "u"}
+	if _, err := cm.AddConfig(ctx, caller, "", "app.json", FileTypeUnspecified, []byte(`{"v": 0}`)); err != nil {
+		t.Fatal(err)
+	}
+
+	events, done := watch(ctx, cm, WatchOptions{Filename: "app.json"})
+	nextEvent(t, events)
+	if event := nextEvent(t, events); event.Type != EventSynced {
+		t.Fatalf("event = %+v, want EventSynced", event)
+	}
+
+	// The watcher reads nothing while the file changes more often than the
+	// subscription buffers; the first event blocks in send
+	for i := 1; i <= subscriptionBuffer+2; i++ {
+		data := []byte(fmt.Sprintf(`{"v": %d}`, i))
+		if _, err := cm.UpdateConfig(ctx, caller, "", "app.json", FileTypeUnspecified, data, Precondition{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// After the buffered events the watch reports the overflow, and the
+	// watcher resumes from the last revision it received
+	last := 0
+	for {
+		select {
+		case event := <-events:
+			last = event.Revision.Number
+			continue
+		case err := <-done:
+			if !errors.Is(err, ErrWatchOverflow) {
+				t.Fatalf("Watch = %v, want %v", err, ErrWatchOverflow)
+			}
+		}
+		break
+	}
+	latest := subscriptionBuffer + 3
+	if last == 0 || last >= latest {
+		t.Fatalf("last revision received %d, want one before %d", last, latest)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	events, _ = watch(ctx, cm, WatchOptions{Filename: "app.json", SinceRevision: last})
+	for number := last + 1; number <= latest; number++ {
+		if event := nextEvent(t, events); event.Type != EventUpdated || event.Revision.Number != number {
+			t.Fatalf("event = %+v, want revision %d", event, number)
+		}
+	}
+	if event := nextEvent(t, events); event.Type != EventSynced {
+		t.Fatalf("event = %+v, want EventSynced", event)
+	}
+}
@@ -16,23 +16,73 @@ import (
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the grpc package it is being compiled against.
-// Requires gRPC-Go v1.64.0 or later.
-const _ = grpc.SupportPackageIsVersion6 // Replace with the correct version constant supported by your gRPC-Go package
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
 
 const (
-	ConfigService_AddConfig_FullMethodName    = "/configmaker.ConfigService/AddConfig"
-	ConfigService_UpdateConfig_FullMethodName = "/configmaker.ConfigService/UpdateConfig"
-	ConfigService_DeleteConfig_FullMethodName = "/configmaker.ConfigService/DeleteConfig"
-	ConfigService_AddUser_FullMethodName      = "/configmaker.ConfigService/AddUser"
-	ConfigService_UpdateUser_FullMethodName   = "/configmaker.ConfigService/UpdateUser"
-	ConfigService_DeleteUser_FullMethodName   = "/configmaker.ConfigService/DeleteUser"
-	ConfigService_GetConfig_FullMethodName    = "/configmaker.ConfigService/GetConfig"
+	ConfigService_Login_FullMethodName                = "/configmaker.ConfigService/Login"
+	ConfigService_Logout_FullMethodName               = "/configmaker.ConfigService/Logout"
+	ConfigService_CreateServiceAccount_FullMethodName = "/configmaker.ConfigService/CreateServiceAccount"
+	ConfigService_ListServiceAccounts_FullMethodName  = "/configmaker.ConfigService/ListServiceAccounts"
+	ConfigService_DeleteServiceAccount_FullMethodName = "/configmaker.ConfigService/DeleteServiceAccount"
+	ConfigService_CreateApiKey_FullMethodName         = "/configmaker.ConfigService/CreateApiKey"
+	ConfigService_ListApiKeys_FullMethodName          = "/configmaker.ConfigService/ListApiKeys"
+	ConfigService_RevokeApiKey_FullMethodName         = "/configmaker.ConfigService/RevokeApiKey"
+	ConfigService_CreateTeam_FullMethodName           = "/configmaker.ConfigService/CreateTeam"
+	ConfigService_ListTeams_FullMethodName            = "/configmaker.ConfigService/ListTeams"
+	ConfigService_DeleteTeam_FullMethodName           = "/configmaker.ConfigService/DeleteTeam"
+	ConfigService_AddTeamMember_FullMethodName        = "/configmaker.ConfigService/AddTeamMember"
+	ConfigService_RemoveTeamMember_FullMethodName     = "/configmaker.ConfigService/RemoveTeamMember"
+	ConfigService_AddConfig_FullMethodName            = "/configmaker.ConfigService/AddConfig"
+	ConfigService_UpdateConfig_FullMethodName         = "/configmaker.ConfigService/UpdateConfig"
+	ConfigService_DeleteConfig_FullMethodName         = "/configmaker.ConfigService/DeleteConfig"
+	ConfigService_AddUser_FullMethodName              = "/configmaker.ConfigService/AddUser"
+	ConfigService_UpdateUser_FullMethodName           = "/configmaker.ConfigService/UpdateUser"
+	ConfigService_DeleteUser_FullMethodName           = "/configmaker.ConfigService/DeleteUser"
+	ConfigService_GetConfig_FullMethodName            = "/configmaker.ConfigService/GetConfig"
+	ConfigService_ListConfigs_FullMethodName          = "/configmaker.ConfigService/ListConfigs"
+	ConfigService_ListDirectory_FullMethodName        = "/configmaker.ConfigService/ListDirectory"
+	ConfigService_DeleteDirectory_FullMethodName      = "/configmaker.ConfigService/DeleteDirectory"
+	ConfigService_MoveConfig_FullMethodName           = "/configmaker.ConfigService/MoveConfig"
+	ConfigService_ListRevisions_FullMethodName        = "/configmaker.ConfigService/ListRevisions"
+	ConfigService_GetRevision_FullMethodName          = "/configmaker.ConfigService/GetRevision"
+	ConfigService_RollbackConfig_FullMethodName       = "/configmaker.ConfigService/RollbackConfig"
+	ConfigService_DiffConfig_FullMethodName           = "/configmaker.ConfigService/DiffConfig"
+	ConfigService_WatchConfig_FullMethodName          = "/configmaker.ConfigService/WatchConfig"
+	ConfigService_ListGrants_FullMethodName           = "/configmaker.ConfigService/ListGrants"
+	ConfigService_SetGrant_FullMethodName             = "/configmaker.ConfigService/SetGrant"
+	ConfigService_SetSchema_FullMethodName            = "/configmaker.ConfigService/SetSchema"
+	ConfigService_DeleteSchema_FullMethodName         = "/configmaker.ConfigService/DeleteSchema"
+	ConfigService_ListSchemas_FullMethodName          = "/configmaker.ConfigService/ListSchemas"
 )
 
 // ConfigServiceClient is the client API for ConfigService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Calls other than Login authenticate with a bearer token issued by Login or
+// an API key. Except for the user management calls and WatchConfig, the
+// user_id and password fields of the request messages are consulted when no
+// token is sent and the server enables legacy_password_auth. Until the first
+// user exists AddUser needs no credentials and creates an admin.
+//
+// Configuration calls address the namespace of the caller unless owner is
+// set. Members of a team have full access to its namespace; files of other
+// namespaces are only accessible through grants.
 type ConfigServiceClient interface {
+	Login(ctx context.Context, in *Login, opts ...grpc.CallOption) (*LoginResponse, error)
+	Logout(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CreateServiceAccount(ctx context.Context, in *CreateServiceAccount, opts ...grpc.CallOption) (*ServiceAccount, error)
+	ListServiceAccounts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListServiceAccountsResponse, error)
+	DeleteServiceAccount(ctx context.Context, in *DeleteServiceAccount, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CreateApiKey(ctx context.Context, in *CreateApiKey, opts ...grpc.CallOption) (*CreateApiKeyResponse, error)
+	ListApiKeys(ctx context.Context, in *ListApiKeys, opts ...grpc.CallOption) (*ListApiKeysResponse, error)
+	RevokeApiKey(ctx context.Context, in *RevokeApiKey, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CreateTeam(ctx context.Context, in *CreateTeam, opts ...grpc.CallOption) (*Team, error)
+	ListTeams(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListTeamsResponse, error)
+	DeleteTeam(ctx context.Context, in *DeleteTeam, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AddTeamMember(ctx context.Context, in *TeamMember, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RemoveTeamMember(ctx context.Context, in *TeamMember, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	AddConfig(ctx context.Context, in *AddConfig, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	UpdateConfig(ctx context.Context, in *UpdateConfig, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeleteConfig(ctx context.Context, in *DeleteConfig, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -40,6 +90,20 @@ type ConfigServiceClient interface {
 	UpdateUser(ctx context.Context, in *UpdateUser, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeleteUser(ctx context.Context, in *DeleteUser, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetConfig(ctx context.Context, in *GetConfig, opts ...grpc.CallOption) (*GetConfigResponse, error)
+	ListConfigs(ctx context.Context, in *ListConfigs, opts ...grpc.CallOption) (*ListConfigsResponse, error)
+	ListDirectory(ctx context.Context, in *ListDirectory, opts ...grpc.CallOption) (*ListDirectoryResponse, error)
+	DeleteDirectory(ctx context.Context, in *DeleteDirectory, opts ...grpc.CallOption) (*DeleteDirectoryResponse, error)
+	MoveConfig(ctx context.Context, in *MoveConfig, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListRevisions(ctx context.Context, in *ListRevisions, opts ...grpc.CallOption) (*ListRevisionsResponse, error)
+	GetRevision(ctx context.Context, in *GetRevision, opts ...grpc.CallOption) (*GetRevisionResponse, error)
+	RollbackConfig(ctx context.Context, in *RollbackConfig, opts ...grpc.CallOption) (*Revision, error)
+	DiffConfig(ctx context.Context, in *DiffConfig, opts ...grpc.CallOption) (*DiffConfigResponse, error)
+	WatchConfig(ctx context.Context, in *WatchConfig, opts ...grpc.CallOption) (ConfigService_WatchConfigClient, error)
+	ListGrants(ctx context.Context, in *ListGrants, opts ...grpc.CallOption) (*ListGrantsResponse, error)
+	SetGrant(ctx context.Context, in *SetGrant, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetSchema(ctx context.Context, in *SetSchema, opts ...grpc.CallOption) (*Schema, error)
+	DeleteSchema(ctx context.Context, in *DeleteSchema, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListSchemas(ctx context.Context, in *ListSchemas, opts ...grpc.CallOption) (*ListSchemasResponse, error)
 }
 
 type configServiceClient struct {
@@ -50,6 +114,136 @@ func NewConfigServiceClient(cc grpc.ClientConnInterface) ConfigServiceClient {
 	return &configServiceClient{cc}
 }
 
+func (c *configServiceClient) Login(ctx context.Context, in *Login, opts ...grpc.CallOption) (*LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, ConfigService_Login_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) Logout(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConfigService_Logout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) CreateServiceAccount(ctx context.Context, in *CreateServiceAccount, opts ...grpc.CallOption) (*ServiceAccount, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServiceAccount)
+	err := c.cc.Invoke(ctx, ConfigService_CreateServiceAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) ListServiceAccounts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListServiceAccountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListServiceAccountsResponse)
+	err := c.cc.Invoke(ctx, ConfigService_ListServiceAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) DeleteServiceAccount(ctx context.Context, in *DeleteServiceAccount, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConfigService_DeleteServiceAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) CreateApiKey(ctx context.Context, in *CreateApiKey, opts ...grpc.CallOption) (*CreateApiKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateApiKeyResponse)
+	err := c.cc.Invoke(ctx, ConfigService_CreateApiKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) ListApiKeys(ctx context.Context, in *ListApiKeys, opts ...grpc.CallOption) (*ListApiKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListApiKeysResponse)
+	err := c.cc.Invoke(ctx, ConfigService_ListApiKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) RevokeApiKey(ctx context.Context, in *RevokeApiKey, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConfigService_RevokeApiKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) CreateTeam(ctx context.Context, in *CreateTeam, opts ...grpc.CallOption) (*Team, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Team)
+	err := c.cc.Invoke(ctx, ConfigService_CreateTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) ListTeams(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListTeamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTeamsResponse)
+	err := c.cc.Invoke(ctx, ConfigService_ListTeams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) DeleteTeam(ctx context.Context, in *DeleteTeam, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConfigService_DeleteTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) AddTeamMember(ctx context.Context, in *TeamMember, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConfigService_AddTeamMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) RemoveTeamMember(ctx context.Context, in *TeamMember, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConfigService_RemoveTeamMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *configServiceClient) AddConfig(ctx context.Context, in *AddConfig, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -120,10 +314,196 @@ func (c *configServiceClient) GetConfig(ctx context.Context, in *GetConfig, opts
 	return out, nil
 }
 
+func (c *configServiceClient) ListConfigs(ctx context.Context, in *ListConfigs, opts ...grpc.CallOption) (*ListConfigsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListConfigsResponse)
+	err := c.cc.Invoke(ctx, ConfigService_ListConfigs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) ListDirectory(ctx context.Context, in *ListDirectory, opts ...grpc.CallOption) (*ListDirectoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDirectoryResponse)
+	err := c.cc.Invoke(ctx, ConfigService_ListDirectory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) DeleteDirectory(ctx context.Context, in *DeleteDirectory, opts ...grpc.CallOption) (*DeleteDirectoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteDirectoryResponse)
+	err := c.cc.Invoke(ctx, ConfigService_DeleteDirectory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) MoveConfig(ctx context.Context, in *MoveConfig, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConfigService_MoveConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) ListRevisions(ctx context.Context, in *ListRevisions, opts ...grpc.CallOption) (*ListRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRevisionsResponse)
+	err := c.cc.Invoke(ctx, ConfigService_ListRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) GetRevision(ctx context.Context, in *GetRevision, opts ...grpc.CallOption) (*GetRevisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRevisionResponse)
+	err := c.cc.Invoke(ctx, ConfigService_GetRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) RollbackConfig(ctx context.Context, in *RollbackConfig, opts ...grpc.CallOption) (*Revision, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Revision)
+	err := c.cc.Invoke(ctx, ConfigService_RollbackConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) DiffConfig(ctx context.Context, in *DiffConfig, opts ...grpc.CallOption) (*DiffConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffConfigResponse)
+	err := c.cc.Invoke(ctx, ConfigService_DiffConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) WatchConfig(ctx context.Context, in *WatchConfig, opts ...grpc.CallOption) (ConfigService_WatchConfigClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ConfigService_ServiceDesc.Streams[0], ConfigService_WatchConfig_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &configServiceWatchConfigClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ConfigService_WatchConfigClient interface {
+	Recv() (*ConfigEvent, error)
+	grpc.ClientStream
+}
+
+type configServiceWatchConfigClient struct {
+	grpc.ClientStream
+}
+
+func (x *configServiceWatchConfigClient) Recv() (*ConfigEvent, error) {
+	m := new(ConfigEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *configServiceClient) ListGrants(ctx context.Context, in *ListGrants, opts ...grpc.CallOption) (*ListGrantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGrantsResponse)
+	err := c.cc.Invoke(ctx, ConfigService_ListGrants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) SetGrant(ctx context.Context, in *SetGrant, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConfigService_SetGrant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) SetSchema(ctx context.Context, in *SetSchema, opts ...grpc.CallOption) (*Schema, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Schema)
+	err := c.cc.Invoke(ctx, ConfigService_SetSchema_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) DeleteSchema(ctx context.Context, in *DeleteSchema, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConfigService_DeleteSchema_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) ListSchemas(ctx context.Context, in *ListSchemas, opts ...grpc.CallOption) (*ListSchemasResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSchemasResponse)
+	err := c.cc.Invoke(ctx, ConfigService_ListSchemas_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ConfigServiceServer is the server API for ConfigService service.
 // All implementations must embed UnimplementedConfigServiceServer
 // for forward compatibility.
+//
+// Calls other than Login authenticate with a bearer token issued by Login or
+// an API key. Except for the user management calls and WatchConfig, the
+// user_id and password fields of the request messages are consulted when no
+// token is sent and the server enables legacy_password_auth. Until the first
+// user exists AddUser needs no credentials and creates an admin.
+//
+// Configuration calls address the namespace of the caller unless owner is
+// set. Members of a team have full access to its namespace; files of other
+// namespaces are only accessible through grants.
 type ConfigServiceServer interface {
+	Login(context.Context, *Login) (*LoginResponse, error)
+	Logout(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	CreateServiceAccount(context.Context, *CreateServiceAccount) (*ServiceAccount, error)
+	ListServiceAccounts(context.Context, *emptypb.Empty) (*ListServiceAccountsResponse, error)
+	DeleteServiceAccount(context.Context, *DeleteServiceAccount) (*emptypb.Empty, error)
+	CreateApiKey(context.Context, *CreateApiKey) (*CreateApiKeyResponse, error)
+	ListApiKeys(context.Context, *ListApiKeys) (*ListApiKeysResponse, error)
+	RevokeApiKey(context.Context, *RevokeApiKey) (*emptypb.Empty, error)
+	CreateTeam(context.Context, *CreateTeam) (*Team, error)
+	ListTeams(context.Context, *emptypb.Empty) (*ListTeamsResponse, error)
+	DeleteTeam(context.Context, *DeleteTeam) (*emptypb.Empty, error)
+	AddTeamMember(context.Context, *TeamMember) (*emptypb.Empty, error)
+	RemoveTeamMember(context.Context, *TeamMember) (*emptypb.Empty, error)
 	AddConfig(context.Context, *AddConfig) (*emptypb.Empty, error)
 	UpdateConfig(context.Context, *UpdateConfig) (*emptypb.Empty, error)
 	DeleteConfig(context.Context, *DeleteConfig) (*emptypb.Empty, error)
@@ -131,6 +511,20 @@ type ConfigServiceServer interface {
 	UpdateUser(context.Context, *UpdateUser) (*emptypb.Empty, error)
 	DeleteUser(context.Context, *DeleteUser) (*emptypb.Empty, error)
 	GetConfig(context.Context, *GetConfig) (*GetConfigResponse, error)
+	ListConfigs(context.Context, *ListConfigs) (*ListConfigsResponse, error)
+	ListDirectory(context.Context, *ListDirectory) (*ListDirectoryResponse, error)
+	DeleteDirectory(context.Context, *DeleteDirectory) (*DeleteDirectoryResponse, error)
+	MoveConfig(context.Context, *MoveConfig) (*emptypb.Empty, error)
+	ListRevisions(context.Context, *ListRevisions) (*ListRevisionsResponse, error)
+	GetRevision(context.Context, *GetRevision) (*GetRevisionResponse, error)
+	RollbackConfig(context.Context, *RollbackConfig) (*Revision, error)
+	DiffConfig(context.Context, *DiffConfig) (*DiffConfigResponse, error)
+	WatchConfig(*WatchConfig, ConfigService_WatchConfigServer) error
+	ListGrants(context.Context, *ListGrants) (*ListGrantsResponse, error)
+	SetGrant(context.Context, *SetGrant) (*emptypb.Empty, error)
+	SetSchema(context.Context, *SetSchema) (*Schema, error)
+	DeleteSchema(context.Context, *DeleteSchema) (*emptypb.Empty, error)
+	ListSchemas(context.Context, *ListSchemas) (*ListSchemasResponse, error)
 	mustEmbedUnimplementedConfigServiceServer()
 }
 
@@ -141,6 +535,45 @@ type ConfigServiceServer interface {
 // pointer dereference when methods are called.
 type UnimplementedConfigServiceServer struct{}
 
+func (UnimplementedConfigServiceServer) Login(context.Context, *Login) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedConfigServiceServer) Logout(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
+func (UnimplementedConfigServiceServer) CreateServiceAccount(context.Context, *CreateServiceAccount) (*ServiceAccount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateServiceAccount not implemented")
+}
+func (UnimplementedConfigServiceServer) ListServiceAccounts(context.Context, *emptypb.Empty) (*ListServiceAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServiceAccounts not implemented")
+}
+func (UnimplementedConfigServiceServer) DeleteServiceAccount(context.Context, *DeleteServiceAccount) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteServiceAccount not implemented")
+}
+func (UnimplementedConfigServiceServer) CreateApiKey(context.Context, *CreateApiKey) (*CreateApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateApiKey not implemented")
+}
+func (UnimplementedConfigServiceServer) ListApiKeys(context.Context, *ListApiKeys) (*ListApiKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListApiKeys not implemented")
+}
+func (UnimplementedConfigServiceServer) RevokeApiKey(context.Context, *RevokeApiKey) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeApiKey not implemented")
+}
+func (UnimplementedConfigServiceServer) CreateTeam(context.Context, *CreateTeam) (*Team, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTeam not implemented")
+}
+func (UnimplementedConfigServiceServer) ListTeams(context.Context, *emptypb.Empty) (*ListTeamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTeams not implemented")
+}
+func (UnimplementedConfigServiceServer) DeleteTeam(context.Context, *DeleteTeam) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTeam not implemented")
+}
+func (UnimplementedConfigServiceServer) AddTeamMember(context.Context, *TeamMember) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTeamMember not implemented")
+}
+func (UnimplementedConfigServiceServer) RemoveTeamMember(context.Context, *TeamMember) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTeamMember not implemented")
+}
 func (UnimplementedConfigServiceServer) AddConfig(context.Context, *AddConfig) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddConfig not implemented")
 }
@@ -162,6 +595,48 @@ func (UnimplementedConfigServiceServer) DeleteUser(context.Context, *DeleteUser)
 func (UnimplementedConfigServiceServer) GetConfig(context.Context, *GetConfig) (*GetConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetConfig not implemented")
 }
+func (UnimplementedConfigServiceServer) ListConfigs(context.Context, *ListConfigs) (*ListConfigsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConfigs not implemented")
+}
+func (UnimplementedConfigServiceServer) ListDirectory(context.Context, *ListDirectory) (*ListDirectoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDirectory not implemented")
+}
+func (UnimplementedConfigServiceServer) DeleteDirectory(context.Context, *DeleteDirectory) (*DeleteDirectoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDirectory not implemented")
+}
+func (UnimplementedConfigServiceServer) MoveConfig(context.Context, *MoveConfig) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveConfig not implemented")
+}
+func (UnimplementedConfigServiceServer) ListRevisions(context.Context, *ListRevisions) (*ListRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRevisions not implemented")
+}
+func (UnimplementedConfigServiceServer) GetRevision(context.Context, *GetRevision) (*GetRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRevision not implemented")
+}
+func (UnimplementedConfigServiceServer) RollbackConfig(context.Context, *RollbackConfig) (*Revision, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackConfig not implemented")
+}
+func (UnimplementedConfigServiceServer) DiffConfig(context.Context, *DiffConfig) (*DiffConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffConfig not implemented")
+}
+func (UnimplementedConfigServiceServer) WatchConfig(*WatchConfig, ConfigService_WatchConfigServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchConfig not implemented")
+}
+func (UnimplementedConfigServiceServer) ListGrants(context.Context, *ListGrants) (*ListGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGrants not implemented")
+}
+func (UnimplementedConfigServiceServer) SetGrant(context.Context, *SetGrant) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGrant not implemented")
+}
+func (UnimplementedConfigServiceServer) SetSchema(context.Context, *SetSchema) (*Schema, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSchema not implemented")
+}
+func (UnimplementedConfigServiceServer) DeleteSchema(context.Context, *DeleteSchema) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSchema not implemented")
+}
+func (UnimplementedConfigServiceServer) ListSchemas(context.Context, *ListSchemas) (*ListSchemasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSchemas not implemented")
+}
 func (UnimplementedConfigServiceServer) mustEmbedUnimplementedConfigServiceServer() {}
 func (UnimplementedConfigServiceServer) testEmbeddedByValue()                       {}
 
@@ -183,61 +658,295 @@ func RegisterConfigServiceServer(s grpc.ServiceRegistrar, srv ConfigServiceServe
 	s.RegisterService(&ConfigService_ServiceDesc, srv)
 }
 
-func _ConfigService_AddConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddConfig)
+func _ConfigService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Login)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServiceServer).AddConfig(ctx, in)
+		return srv.(ConfigServiceServer).Login(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ConfigService_AddConfig_FullMethodName,
+		FullMethod: ConfigService_Login_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServiceServer).AddConfig(ctx, req.(*AddConfig))
+		return srv.(ConfigServiceServer).Login(ctx, req.(*Login))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ConfigService_UpdateConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateConfig)
+func _ConfigService_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServiceServer).UpdateConfig(ctx, in)
+		return srv.(ConfigServiceServer).Logout(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ConfigService_UpdateConfig_FullMethodName,
+		FullMethod: ConfigService_Logout_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServiceServer).UpdateConfig(ctx, req.(*UpdateConfig))
+		return srv.(ConfigServiceServer).Logout(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ConfigService_DeleteConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteConfig)
+func _ConfigService_CreateServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateServiceAccount)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServiceServer).DeleteConfig(ctx, in)
+		return srv.(ConfigServiceServer).CreateServiceAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ConfigService_DeleteConfig_FullMethodName,
+		FullMethod: ConfigService_CreateServiceAccount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServiceServer).DeleteConfig(ctx, req.(*DeleteConfig))
+		return srv.(ConfigServiceServer).CreateServiceAccount(ctx, req.(*CreateServiceAccount))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ConfigService_AddUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _ConfigService_ListServiceAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).ListServiceAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_ListServiceAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).ListServiceAccounts(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_DeleteServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteServiceAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).DeleteServiceAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_DeleteServiceAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).DeleteServiceAccount(ctx, req.(*DeleteServiceAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_CreateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApiKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).CreateApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_CreateApiKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).CreateApiKey(ctx, req.(*CreateApiKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_ListApiKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApiKeys)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).ListApiKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_ListApiKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).ListApiKeys(ctx, req.(*ListApiKeys))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_RevokeApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeApiKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).RevokeApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_RevokeApiKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).RevokeApiKey(ctx, req.(*RevokeApiKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_CreateTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTeam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).CreateTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_CreateTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).CreateTeam(ctx, req.(*CreateTeam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_ListTeams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).ListTeams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_ListTeams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).ListTeams(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_DeleteTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTeam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).DeleteTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_DeleteTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).DeleteTeam(ctx, req.(*DeleteTeam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_AddTeamMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TeamMember)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).AddTeamMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_AddTeamMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).AddTeamMember(ctx, req.(*TeamMember))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_RemoveTeamMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TeamMember)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).RemoveTeamMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_RemoveTeamMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).RemoveTeamMember(ctx, req.(*TeamMember))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_AddConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).AddConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_AddConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).AddConfig(ctx, req.(*AddConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_UpdateConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).UpdateConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_UpdateConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).UpdateConfig(ctx, req.(*UpdateConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_DeleteConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).DeleteConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_DeleteConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).DeleteConfig(ctx, req.(*DeleteConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_AddUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddUser)
 	if err := dec(in); err != nil {
 		return nil, err
@@ -309,6 +1018,261 @@ func _ConfigService_GetConfig_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ConfigService_ListConfigs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConfigs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).ListConfigs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_ListConfigs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).ListConfigs(ctx, req.(*ListConfigs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_ListDirectory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDirectory)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).ListDirectory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_ListDirectory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).ListDirectory(ctx, req.(*ListDirectory))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_DeleteDirectory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDirectory)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).DeleteDirectory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_DeleteDirectory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).DeleteDirectory(ctx, req.(*DeleteDirectory))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_MoveConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).MoveConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_MoveConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).MoveConfig(ctx, req.(*MoveConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_ListRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRevisions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).ListRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_ListRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).ListRevisions(ctx, req.(*ListRevisions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_GetRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRevision)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).GetRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_GetRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).GetRevision(ctx, req.(*GetRevision))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_RollbackConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).RollbackConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_RollbackConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).RollbackConfig(ctx, req.(*RollbackConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_DiffConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).DiffConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_DiffConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).DiffConfig(ctx, req.(*DiffConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_WatchConfig_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchConfig)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ConfigServiceServer).WatchConfig(m, &configServiceWatchConfigServer{ServerStream: stream})
+}
+
+type ConfigService_WatchConfigServer interface {
+	Send(*ConfigEvent) error
+	grpc.ServerStream
+}
+
+type configServiceWatchConfigServer struct {
+	grpc.ServerStream
+}
+
+func (x *configServiceWatchConfigServer) Send(m *ConfigEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ConfigService_ListGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGrants)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).ListGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_ListGrants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).ListGrants(ctx, req.(*ListGrants))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_SetGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGrant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).SetGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_SetGrant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).SetGrant(ctx, req.(*SetGrant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_SetSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSchema)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).SetSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_SetSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).SetSchema(ctx, req.(*SetSchema))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_DeleteSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSchema)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).DeleteSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_DeleteSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).DeleteSchema(ctx, req.(*DeleteSchema))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_ListSchemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSchemas)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).ListSchemas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_ListSchemas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).ListSchemas(ctx, req.(*ListSchemas))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ConfigService_ServiceDesc is the grpc.ServiceDesc for ConfigService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -316,6 +1280,58 @@ var ConfigService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "configmaker.ConfigService",
 	HandlerType: (*ConfigServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Login",
+			Handler:    _ConfigService_Login_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _ConfigService_Logout_Handler,
+		},
+		{
+			MethodName: "CreateServiceAccount",
+			Handler:    _ConfigService_CreateServiceAccount_Handler,
+		},
+		{
+			MethodName: "ListServiceAccounts",
+			Handler:    _ConfigService_ListServiceAccounts_Handler,
+		},
+		{
+			MethodName: "DeleteServiceAccount",
+			Handler:    _ConfigService_DeleteServiceAccount_Handler,
+		},
+		{
+			MethodName: "CreateApiKey",
+			Handler:    _ConfigService_CreateApiKey_Handler,
+		},
+		{
+			MethodName: "ListApiKeys",
+			Handler:    _ConfigService_ListApiKeys_Handler,
+		},
+		{
+			MethodName: "RevokeApiKey",
+			Handler:    _ConfigService_RevokeApiKey_Handler,
+		},
+		{
+			MethodName: "CreateTeam",
+			Handler:    _ConfigService_CreateTeam_Handler,
+		},
+		{
+			MethodName: "ListTeams",
+			Handler:    _ConfigService_ListTeams_Handler,
+		},
+		{
+			MethodName: "DeleteTeam",
+			Handler:    _ConfigService_DeleteTeam_Handler,
+		},
+		{
+			MethodName: "AddTeamMember",
+			Handler:    _ConfigService_AddTeamMember_Handler,
+		},
+		{
+			MethodName: "RemoveTeamMember",
+			Handler:    _ConfigService_RemoveTeamMember_Handler,
+		},
 		{
 			MethodName: "AddConfig",
 			Handler:    _ConfigService_AddConfig_Handler,
@@ -344,7 +1360,65 @@ var ConfigService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetConfig",
 			Handler:    _ConfigService_GetConfig_Handler,
 		},
+		{
+			MethodName: "ListConfigs",
+			Handler:    _ConfigService_ListConfigs_Handler,
+		},
+		{
+			MethodName: "ListDirectory",
+			Handler:    _ConfigService_ListDirectory_Handler,
+		},
+		{
+			MethodName: "DeleteDirectory",
+			Handler:    _ConfigService_DeleteDirectory_Handler,
+		},
+		{
+			MethodName: "MoveConfig",
+			Handler:    _ConfigService_MoveConfig_Handler,
+		},
+		{
+			MethodName: "ListRevisions",
+			Handler:    _ConfigService_ListRevisions_Handler,
+		},
+		{
+			MethodName: "GetRevision",
+			Handler:    _ConfigService_GetRevision_Handler,
+		},
+		{
+			MethodName: "RollbackConfig",
+			Handler:    _ConfigService_RollbackConfig_Handler,
+		},
+		{
+			MethodName: "DiffConfig",
+			Handler:    _ConfigService_DiffConfig_Handler,
+		},
+		{
+			MethodName: "ListGrants",
+			Handler:    _ConfigService_ListGrants_Handler,
+		},
+		{
+			MethodName: "SetGrant",
+			Handler:    _ConfigService_SetGrant_Handler,
+		},
+		{
+			MethodName: "SetSchema",
+			Handler:    _ConfigService_SetSchema_Handler,
+		},
+		{
+			MethodName: "DeleteSchema",
+			Handler:    _ConfigService_DeleteSchema_Handler,
+		},
+		{
+			MethodName: "ListSchemas",
+			Handler:    _ConfigService_ListSchemas_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchConfig",
+			Handler:       _ConfigService_WatchConfig_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "config_maker.proto",
 }
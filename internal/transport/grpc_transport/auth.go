@@ -0,0 +1,240 @@
+package grpc_transport
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/yash3004/config_server/configurations"
+	pb "github.com/yash3004/config_server/generated/protobuf/configpb"
+	"github.com/yash3004/config_server/users"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// publicMethods can be called without authenticating
+var publicMethods = map[string]bool{
+	pb.ConfigService_Login_FullMethodName:  true,
+	pb.ConfigService_Logout_FullMethodName: true,
+}
+
+// methodPermissions maps every authenticated method to the permission its
+// caller needs. Methods missing from the map are denied.
+var methodPermissions = map[string]users.Permission{
+	pb.ConfigService_GetConfig_FullMethodName:     users.PermissionReadConfigs,
+	pb.ConfigService_ListConfigs_FullMethodName:   users.PermissionReadConfigs,
+	pb.ConfigService_ListDirectory_FullMethodName: users.PermissionReadConfigs,
+	pb.ConfigService_ListRevisions_FullMethodName: users.PermissionReadConfigs,
+	pb.ConfigService_GetRevision_FullMethodName:   users.PermissionReadConfigs,
+	pb.ConfigService_DiffConfig_FullMethodName:    users.PermissionReadConfigs,
+	pb.ConfigService_WatchConfig_FullMethodName:   users.PermissionReadConfigs,
+	pb.ConfigService_ListGrants_FullMethodName:    users.PermissionReadConfigs,
+	pb.ConfigService_ListSchemas_FullMethodName:   users.PermissionReadConfigs,
+	pb.ConfigService_ListTeams_FullMethodName:     users.PermissionReadConfigs,
+
+	pb.ConfigService_AddConfig_FullMethodName:       users.PermissionWriteConfigs,
+	pb.ConfigService_UpdateConfig_FullMethodName:    users.PermissionWriteConfigs,
+	pb.ConfigService_DeleteConfig_FullMethodName:    users.PermissionWriteConfigs,
+	pb.ConfigService_DeleteDirectory_FullMethodName: users.PermissionWriteConfigs,
+	pb.ConfigService_MoveConfig_FullMethodName:      users.PermissionWriteConfigs,
+	pb.ConfigService_RollbackConfig_FullMethodName:  users.PermissionWriteConfigs,
+	pb.ConfigService_SetGrant_FullMethodName:        users.PermissionWriteConfigs,
+	pb.ConfigService_SetSchema_FullMethodName:       users.PermissionWriteConfigs,
+	pb.ConfigService_DeleteSchema_FullMethodName:    users.PermissionWriteConfigs,
+
+	pb.ConfigService_CreateServiceAccount_FullMethodName: users.PermissionManageServiceAccounts,
+	pb.ConfigService_ListServiceAccounts_FullMethodName:  users.PermissionManageServiceAccounts,
+	pb.ConfigService_DeleteServiceAccount_FullMethodName: users.PermissionManageServiceAccounts,
+	pb.ConfigService_CreateApiKey_FullMethodName:         users.PermissionManageServiceAccounts,
+	pb.ConfigService_ListApiKeys_FullMethodName:          users.PermissionManageServiceAccounts,
+	pb.ConfigService_RevokeApiKey_FullMethodName:         users.PermissionManageServiceAccounts,
+
+	pb.ConfigService_CreateTeam_FullMethodName:       users.PermissionManageTeams,
+	pb.ConfigService_DeleteTeam_FullMethodName:       users.PermissionManageTeams,
+	pb.ConfigService_AddTeamMember_FullMethodName:    users.PermissionManageTeams,
+	pb.ConfigService_RemoveTeamMember_FullMethodName: users.PermissionManageTeams,
+
+	pb.ConfigService_AddUser_FullMethodName:    users.PermissionManageUsers,
+	pb.ConfigService_DeleteUser_FullMethodName: users.PermissionManageUsers,
+}
+
+// selfServiceMethods are authorized by their handler because users may call
+// them on themselves without the permission to manage users
+var selfServiceMethods = map[string]bool{
+	pb.ConfigService_UpdateUser_FullMethodName: true,
+}
+
+// userMethods only accept bearer credentials, even with legacy passwords
+// enabled; the user_id and password fields of their messages describe the
+// user being managed
+var userMethods = map[string]bool{
+	pb.ConfigService_AddUser_FullMethodName:    true,
+	pb.ConfigService_UpdateUser_FullMethodName: true,
+	pb.ConfigService_DeleteUser_FullMethodName: true,
+}
+
+// passwordCredentials is implemented by request messages that still carry
+// the deprecated user_id and password fields
+type passwordCredentials interface {
+	GetUserId() string
+	GetPassword() string
+}
+
+// authInterceptor authenticates and authorizes every call other than the
+// public methods and stores the identity of the caller in the context of the
+// handler
+func (s *Server) authInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if publicMethods[info.FullMethod] {
+		return handler(ctx, req)
+	}
+
+	if info.FullMethod == pb.ConfigService_AddUser_FullMethodName {
+		hasUsers, err := s.userManager.HasUsers(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !hasUsers {
+			// The first user is added without credentials and becomes an admin
+			return handler(ctx, req)
+		}
+	}
+
+	allowPassword := s.legacyPasswords && !userMethods[info.FullMethod]
+	ctx, err := s.authorizeCall(ctx, info.FullMethod, req, allowPassword)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// authStreamInterceptor is the counterpart of authInterceptor for streaming
+// calls. Their request is not known yet when the call starts, so they only
+// accept bearer credentials.
+func (s *Server) authStreamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := s.authorizeCall(stream.Context(), info.FullMethod, nil, false)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &identityStream{ServerStream: stream, ctx: ctx})
+}
+
+// identityStream replaces the context of a server stream with one carrying
+// the identity of the caller
+type identityStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *identityStream) Context() context.Context {
+	return s.ctx
+}
+
+// authorizeCall authenticates the caller of method, checks its permission
+// and returns a context carrying its identity
+func (s *Server) authorizeCall(ctx context.Context, method string, req interface{}, allowPassword bool) (context.Context, error) {
+	identity, err := s.authenticate(ctx, req, allowPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeMethod(identity, method); err != nil {
+		return nil, err
+	}
+	return users.ContextWithIdentity(ctx, identity), nil
+}
+
+// authorizeMethod checks that the role of identity grants the permission
+// method needs
+func authorizeMethod(identity *users.Identity, method string) error {
+	if selfServiceMethods[method] {
+		return nil
+	}
+	permission, ok := methodPermissions[method]
+	if !ok || users.Authorize(identity, permission) != nil {
+		return status.Error(codes.PermissionDenied, users.ErrPermissionDenied.Error())
+	}
+	return nil
+}
+
+// authenticate returns the identity of the caller from a bearer token or API
+// key, falling back to the password fields of the request if allowPassword
+// is set
+func (s *Server) authenticate(ctx context.Context, req interface{}, allowPassword bool) (*users.Identity, error) {
+	if token, ok := bearerToken(ctx); ok {
+		identity, err := s.userManager.VerifyBearer(ctx, token)
+		if errors.Is(err, users.ErrInvalidToken) {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		return identity, err
+	}
+
+	creds, ok := req.(passwordCredentials)
+	if !allowPassword || !ok || creds.GetPassword() == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing bearer token")
+	}
+	identity, err := s.userManager.AuthenticatePassword(ctx, creds.GetUserId(), creds.GetPassword())
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication failed")
+	}
+	return identity, nil
+}
+
+// requireUser returns the ID of the calling user, rejecting callers that
+// authenticated as a service account
+func requireUser(ctx context.Context) (string, error) {
+	identity := users.IdentityFromContext(ctx)
+	if identity == nil || identity.ServiceAccount != "" {
+		return "", status.Error(codes.PermissionDenied, "only users may call this method")
+	}
+	return identity.UserID, nil
+}
+
+// callerFromContext returns the authenticated caller of a configuration
+// call together with its teams
+func callerFromContext(ctx context.Context) configurations.Caller {
+	identity := users.IdentityFromContext(ctx)
+	if identity == nil {
+		return configurations.Caller{}
+	}
+	return configurations.Caller{UserID: identity.UserID, Teams: identity.Teams}
+}
+
+// bearerToken returns the token of the authorization metadata of a call
+func bearerToken(ctx context.Context) (string, bool) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, value := range md.Get("authorization") {
+		scheme, token, found := strings.Cut(value, " ")
+		if found && strings.EqualFold(scheme, "Bearer") && token != "" {
+			return strings.TrimSpace(token), true
+		}
+	}
+	return "", false
+}
+
+func (s *Server) Login(ctx context.Context, req *pb.Login) (*pb.LoginResponse, error) {
+	token, expiresAt, err := s.userManager.IssueToken(ctx, req.GetUserId(), req.GetPassword())
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication failed")
+	}
+
+	return &pb.LoginResponse{
+		Token:     token,
+		ExpiresAt: timestamppb.New(expiresAt),
+	}, nil
+}
+
+func (s *Server) Logout(ctx context.Context, req *emptypb.Empty) (*emptypb.Empty, error) {
+	token, ok := bearerToken(ctx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "logout requires a bearer token")
+	}
+
+	if err := s.userManager.RevokeToken(ctx, token); err != nil && !errors.Is(err, users.ErrInvalidToken) {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,172 @@
+package grpc_transport
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/yash3004/config_server/generated/protobuf/configpb"
+	"github.com/yash3004/config_server/users"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newTestServer returns a server whose user manager cannot reach its
+// database, so every credential it is asked to check is rejected with
+// "authentication failed"
+func newTestServer(t *testing.T, legacyPasswords bool) *Server {
+	t.Helper()
+	client, err := mongo.Connect(context.Background(), options.Client().
+		ApplyURI("mongodb://127.0.0.1:1").
+		SetServerSelectionTimeout(10*time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { client.Disconnect(context.Background()) })
+	return NewServer(users.NewUserManager(client.Database("test"), 0), nil, legacyPasswords)
+}
+
+func TestLegacyPasswords(t *testing.T) {
+	withPassword := &pb.GetConfig{UserId: "alice", Password: "secret", Filename: "app.json"}
+	tests := []struct {
+		name            string
+		legacyPasswords bool
+		method          string
+		req             interface{}
+		// message is "" for calls reaching the handler, "missing bearer
+		// token" for calls whose password was ignored and "authentication
+		// failed" for calls whose password was checked
+		message string
+	}{
+		{
+			name:    "passwords are ignored by default",
+			method:  pb.ConfigService_GetConfig_FullMethodName,
+			req:     withPassword,
+			message: "missing bearer token",
+		},
+		{
+			name:            "passwords are checked when enabled",
+			legacyPasswords: true,
+			method:          pb.ConfigService_GetConfig_FullMethodName,
+			req:             withPassword,
+			message:         "authentication failed",
+		},
+		{
+			name:            "requests without a password still need a token",
+			legacyPasswords: true,
+			method:          pb.ConfigService_GetConfig_FullMethodName,
+			req:             &pb.GetConfig{UserId: "alice", Filename: "app.json"},
+			message:         "missing bearer token",
+		},
+		{
+			name:            "user methods never accept passwords",
+			legacyPasswords: true,
+			method:          pb.ConfigService_UpdateUser_FullMethodName,
+			req:             &pb.UpdateUser{UserId: "alice", Password: "secret"},
+			message:         "missing bearer token",
+		},
+		{
+			name:   "login is public",
+			method: pb.ConfigService_Login_FullMethodName,
+			req:    &pb.Login{UserId: "alice", Password: "secret"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := newTestServer(t, test.legacyPasswords)
+			called := false
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+				return nil, nil
+			}
+
+			_, err := s.authInterceptor(context.Background(), test.req, &grpc.UnaryServerInfo{FullMethod: test.method}, handler)
+			if test.message == "" {
+				if err != nil || !called {
+					t.Fatalf("handler called %v, error %v; want the handler to be called", called, err)
+				}
+				return
+			}
+			if called {
+				t.Fatal("handler called without credentials")
+			}
+			if st := status.Convert(err); st.Code() != codes.Unauthenticated || st.Message() != test.message {
+				t.Errorf("error = %v, want Unauthenticated %q", err, test.message)
+			}
+		})
+	}
+}
+
+// TestMethodPermissions checks that every method of the service is either
+// public, self-service or has a permission, and that no permission is left
+// for a method that no longer exists
+func TestMethodPermissions(t *testing.T) {
+	methods := make(map[string]bool)
+	for _, method := range pb.ConfigService_ServiceDesc.Methods {
+		methods["/"+pb.ConfigService_ServiceDesc.ServiceName+"/"+method.MethodName] = true
+	}
+	for _, stream := range pb.ConfigService_ServiceDesc.Streams {
+		methods["/"+pb.ConfigService_ServiceDesc.ServiceName+"/"+stream.StreamName] = true
+	}
+
+	for method := range methods {
+		_, ok := methodPermissions[method]
+		if !ok && !publicMethods[method] && !selfServiceMethods[method] {
+			t.Errorf("%s has no permission and would always be denied", method)
+		}
+		if ok && (publicMethods[method] || selfServiceMethods[method]) {
+			t.Errorf("%s has a permission that is never checked", method)
+		}
+	}
+	for method := range methodPermissions {
+		if !methods[method] {
+			t.Errorf("permission for unknown method %s", method)
+		}
+	}
+}
+
+func TestAuthorizeMethod(t *testing.T) {
+	viewer := &users.Identity{UserID: "v", Role: users.RoleViewer}
+	editor := &users.Identity{UserID: "e", Role: users.RoleEditor}
+	admin := &users.Identity{UserID: "a", Role: users.RoleAdmin}
+	tests := []struct {
+		name     string
+		identity *users.Identity
+		method   string
+		allowed  bool
+	}{
+		{name: "viewer reads", identity: viewer, method: pb.ConfigService_GetConfig_FullMethodName, allowed: true},
+		{name: "viewer watches", identity: viewer, method: pb.ConfigService_WatchConfig_FullMethodName, allowed: true},
+		{name: "viewer adds a file", identity: viewer, method: pb.ConfigService_AddConfig_FullMethodName},
+		{name: "viewer updates a file", identity: viewer, method: pb.ConfigService_UpdateConfig_FullMethodName},
+		{name: "viewer deletes a file", identity: viewer, method: pb.ConfigService_DeleteConfig_FullMethodName},
+		{name: "viewer rolls back", identity: viewer, method: pb.ConfigService_RollbackConfig_FullMethodName},
+		{name: "viewer shares", identity: viewer, method: pb.ConfigService_SetGrant_FullMethodName},
+		{name: "viewer creates a service account", identity: viewer, method: pb.ConfigService_CreateServiceAccount_FullMethodName},
+		{name: "viewer updates itself", identity: viewer, method: pb.ConfigService_UpdateUser_FullMethodName, allowed: true},
+		{name: "editor writes", identity: editor, method: pb.ConfigService_UpdateConfig_FullMethodName, allowed: true},
+		{name: "editor creates a team", identity: editor, method: pb.ConfigService_CreateTeam_FullMethodName, allowed: true},
+		{name: "editor adds a user", identity: editor, method: pb.ConfigService_AddUser_FullMethodName},
+		{name: "editor deletes a user", identity: editor, method: pb.ConfigService_DeleteUser_FullMethodName},
+		{name: "admin adds a user", identity: admin, method: pb.ConfigService_AddUser_FullMethodName, allowed: true},
+		{name: "admin calls an unknown method", identity: admin, method: "/config.ConfigService/Unknown"},
+		{name: "no identity", method: pb.ConfigService_GetConfig_FullMethodName},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := authorizeMethod(test.identity, test.method)
+			if test.allowed {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if status.Code(err) != codes.PermissionDenied {
+				t.Errorf("got %v, want PermissionDenied", err)
+			}
+		})
+	}
+}
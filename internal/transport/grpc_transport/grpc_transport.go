@@ -2,25 +2,35 @@ package grpc_transport
 
 import (
 	"context"
+	"errors"
 	"net"
 
 	"github.com/yash3004/config_server/configurations"
 	pb "github.com/yash3004/config_server/generated/protobuf/configpb"
 	"github.com/yash3004/config_server/users"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Server struct {
 	pb.UnimplementedConfigServiceServer
 	userManager   *users.UserManager
 	configManager *configurations.ConfigManager
+	// legacyPasswords accepts the user_id and password fields of requests
+	// without a bearer token
+	legacyPasswords bool
 }
 
-func NewServer(userManager *users.UserManager, configManager *configurations.ConfigManager) *Server {
+// NewServer creates a new gRPC server. legacyPasswords keeps accepting the
+// password fields of requests for clients that do not use tokens yet.
+func NewServer(userManager *users.UserManager, configManager *configurations.ConfigManager, legacyPasswords bool) *Server {
 	return &Server{
-		userManager:   userManager,
-		configManager: configManager,
+		userManager:     userManager,
+		configManager:   configManager,
+		legacyPasswords: legacyPasswords,
 	}
 }
 
@@ -30,95 +40,430 @@ func StartGRPCServer(server *Server, address string) error {
 		return err
 	}
 
-	s := grpc.NewServer()
+	s := grpc.NewServer(
+		grpc.UnaryInterceptor(server.authInterceptor),
+		grpc.StreamInterceptor(server.authStreamInterceptor),
+	)
 	pb.RegisterConfigServiceServer(s, server)
 	return s.Serve(lis)
 }
 
 func (s *Server) AddConfig(ctx context.Context, req *pb.AddConfig) (*emptypb.Empty, error) {
-	authenticated, err := s.userManager.AuthenticateUser(ctx, req.GetUserId(), req.GetPassword())
-	if err != nil || !authenticated {
-		return nil, err
-	}
+	caller := callerFromContext(ctx)
 
-	err = s.configManager.AddConfig(ctx, req.GetUserId(), req.GetFilename(), int(req.GetFileType()), req.GetData())
+	_, err := s.configManager.AddConfig(ctx, caller, req.GetOwner(), req.GetFilename(), fileTypeFromProto(req.GetFileType()), req.GetData())
 	if err != nil {
-		return nil, err
+		return nil, toStatus(err)
 	}
 
 	return &emptypb.Empty{}, nil
 }
 
 func (s *Server) UpdateConfig(ctx context.Context, req *pb.UpdateConfig) (*emptypb.Empty, error) {
-	authenticated, err := s.userManager.AuthenticateUser(ctx, req.GetUserId(), req.GetPassword())
-	if err != nil || !authenticated {
-		return nil, err
-	}
+	caller := callerFromContext(ctx)
 
-	err = s.configManager.UpdateConfig(ctx, req.GetUserId(), req.GetFilename(), int(req.GetFileType()), req.GetData())
+	cond := configurations.Precondition{Revision: int(req.GetExpectedRevision())}
+	_, err := s.configManager.UpdateConfig(ctx, caller, req.GetOwner(), req.GetFilename(), fileTypeFromProto(req.GetFileType()), req.GetData(), cond)
 	if err != nil {
-		return nil, err
+		return nil, toStatus(err)
 	}
 
 	return &emptypb.Empty{}, nil
 }
 
 func (s *Server) DeleteConfig(ctx context.Context, req *pb.DeleteConfig) (*emptypb.Empty, error) {
-	authenticated, err := s.userManager.AuthenticateUser(ctx, req.GetUserId(), req.GetPassword())
-	if err != nil || !authenticated {
-		return nil, err
-	}
+	caller := callerFromContext(ctx)
 
-	err = s.configManager.DeleteConfig(ctx, req.GetUserId(), req.GetFilename())
+	cond := configurations.Precondition{Revision: int(req.GetExpectedRevision())}
+	err := s.configManager.DeleteConfig(ctx, caller, req.GetOwner(), req.GetFilename(), cond)
 	if err != nil {
-		return nil, err
+		return nil, toStatus(err)
 	}
 
 	return &emptypb.Empty{}, nil
 }
 
 func (s *Server) GetConfig(ctx context.Context, req *pb.GetConfig) (*pb.GetConfigResponse, error) {
-	authenticated, err := s.userManager.AuthenticateUser(ctx, req.GetUserId(), req.GetPassword())
-	if err != nil || !authenticated {
-		return nil, err
+	caller := callerFromContext(ctx)
+
+	format, err := configurations.ParseFormat(req.GetFormat())
+	if err != nil {
+		return nil, toStatus(err)
 	}
 
-	data, fileType, err := s.configManager.GetConfig(ctx, req.GetUserId(), req.GetFilename())
+	data, file, err := s.configManager.GetConfig(ctx, caller, req.GetOwner(), req.GetFilename())
 	if err != nil {
-		return nil, err
+		return nil, toStatus(err)
+	}
+	if data, err = configurations.ConvertConfig(data, file.FileType, format); err != nil {
+		return nil, toStatus(err)
 	}
 
 	return &pb.GetConfigResponse{
-		UserId:   req.GetUserId(),
+		UserId:   file.UserID,
 		Filename: req.GetFilename(),
-		FileType: pb.FileType(fileType),
+		FileType: fileTypeToProto(file.FileType),
 		Data:     data,
+		Etag:     file.Hash,
+		Revision: int64(file.Revision),
+		Format:   string(format),
 	}, nil
 }
 
+func (s *Server) ListConfigs(ctx context.Context, req *pb.ListConfigs) (*pb.ListConfigsResponse, error) {
+	caller := callerFromContext(ctx)
+
+	files, next, err := s.configManager.ListConfigs(ctx, caller, req.GetOwner(), req.GetPrefix(), req.GetCursor(), int(req.GetLimit()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	response := &pb.ListConfigsResponse{NextCursor: next}
+	for _, file := range files {
+		response.Configs = append(response.Configs, configInfoToProto(file))
+	}
+	return response, nil
+}
+
+func (s *Server) ListDirectory(ctx context.Context, req *pb.ListDirectory) (*pb.ListDirectoryResponse, error) {
+	caller := callerFromContext(ctx)
+
+	entries, err := s.configManager.ListDir(ctx, caller, req.GetOwner(), req.GetPath())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	response := &pb.ListDirectoryResponse{}
+	for _, entry := range entries {
+		pbEntry := &pb.DirectoryEntry{
+			Name:        entry.Name,
+			Path:        entry.Path,
+			IsDirectory: entry.IsDir,
+		}
+		if entry.File != nil {
+			pbEntry.Config = configInfoToProto(entry.File)
+		}
+		response.Entries = append(response.Entries, pbEntry)
+	}
+	return response, nil
+}
+
+func (s *Server) DeleteDirectory(ctx context.Context, req *pb.DeleteDirectory) (*pb.DeleteDirectoryResponse, error) {
+	caller := callerFromContext(ctx)
+
+	deleted, err := s.configManager.DeleteDir(ctx, caller, req.GetOwner(), req.GetPath())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.DeleteDirectoryResponse{Deleted: int64(deleted)}, nil
+}
+
+func (s *Server) MoveConfig(ctx context.Context, req *pb.MoveConfig) (*emptypb.Empty, error) {
+	caller := callerFromContext(ctx)
+
+	err := s.configManager.MoveConfig(ctx, caller, req.GetOwner(), req.GetFrom(), req.GetTo())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func configInfoToProto(file *configurations.ConfigFile) *pb.ConfigInfo {
+	return &pb.ConfigInfo{
+		Filename:  file.Filename,
+		FileType:  fileTypeToProto(file.FileType),
+		Size:      file.Size,
+		CreatedAt: timestamppb.New(file.CreatedAt),
+		UpdatedAt: timestamppb.New(file.UpdatedAt),
+		Revision:  int64(file.Revision),
+		Etag:      file.Hash,
+	}
+}
+
+func (s *Server) ListRevisions(ctx context.Context, req *pb.ListRevisions) (*pb.ListRevisionsResponse, error) {
+	caller := callerFromContext(ctx)
+
+	revisions, err := s.configManager.ListRevisions(ctx, caller, req.GetOwner(), req.GetFilename())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	response := &pb.ListRevisionsResponse{}
+	for _, revision := range revisions {
+		response.Revisions = append(response.Revisions, revisionToProto(revision))
+	}
+	return response, nil
+}
+
+func (s *Server) GetRevision(ctx context.Context, req *pb.GetRevision) (*pb.GetRevisionResponse, error) {
+	caller := callerFromContext(ctx)
+
+	data, revision, err := s.configManager.GetRevision(ctx, caller, req.GetOwner(), req.GetFilename(), int(req.GetRevision()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.GetRevisionResponse{
+		UserId:   caller.Namespace(req.GetOwner()),
+		Filename: req.GetFilename(),
+		FileType: fileTypeToProto(revision.FileType),
+		Data:     data,
+		Revision: revisionToProto(revision),
+	}, nil
+}
+
+func (s *Server) RollbackConfig(ctx context.Context, req *pb.RollbackConfig) (*pb.Revision, error) {
+	caller := callerFromContext(ctx)
+
+	revision, err := s.configManager.RollbackConfig(ctx, caller, req.GetOwner(), req.GetFilename(), int(req.GetRevision()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return revisionToProto(revision), nil
+}
+
+func (s *Server) DiffConfig(ctx context.Context, req *pb.DiffConfig) (*pb.DiffConfigResponse, error) {
+	caller := callerFromContext(ctx)
+
+	diff, err := s.configManager.DiffConfig(ctx, caller, req.GetOwner(), req.GetFilename(), int(req.GetFromRevision()), int(req.GetToRevision()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	response := &pb.DiffConfigResponse{
+		FromRevision: int64(diff.From),
+		ToRevision:   int64(diff.To),
+		Unified:      diff.Unified,
+		Structural:   diff.Structural,
+	}
+	for _, change := range diff.Changes {
+		response.Changes = append(response.Changes, &pb.KeyChange{
+			Path:     change.Path,
+			Change:   changeTypeToProto(change.Kind),
+			OldValue: change.OldValue,
+			NewValue: change.NewValue,
+		})
+	}
+	return response, nil
+}
+
+func changeTypeToProto(kind configurations.ChangeKind) pb.ChangeType {
+	switch kind {
+	case configurations.ChangeAdded:
+		return pb.ChangeType_CHANGE_TYPE_ADDED
+	case configurations.ChangeRemoved:
+		return pb.ChangeType_CHANGE_TYPE_REMOVED
+	case configurations.ChangeModified:
+		return pb.ChangeType_CHANGE_TYPE_MODIFIED
+	default:
+		return pb.ChangeType_CHANGE_TYPE_UNSPECIFIED
+	}
+}
+
+// toStatus converts configuration and user errors into gRPC status errors.
+// Invalid content is described by a ContentError detail and schema
+// violations by a SchemaViolation detail each.
+func toStatus(err error) error {
+	var validationErr *configurations.ValidationError
+	if errors.As(err, &validationErr) {
+		s, detailErr := status.New(codes.InvalidArgument, err.Error()).WithDetails(&pb.ContentError{
+			FileType: fileTypeToProto(validationErr.FileType),
+			Line:     int32(validationErr.Line),
+			Column:   int32(validationErr.Column),
+			Message:  validationErr.Message,
+		})
+		if detailErr == nil {
+			return s.Err()
+		}
+	}
+	var schemaErr *configurations.SchemaError
+	if errors.As(err, &schemaErr) {
+		s := status.New(codes.InvalidArgument, err.Error())
+		for _, violation := range schemaErr.Violations {
+			if detailed, detailErr := s.WithDetails(&pb.SchemaViolation{
+				Schema:  violation.Schema,
+				Path:    violation.Path,
+				Message: violation.Message,
+			}); detailErr == nil {
+				s = detailed
+			}
+		}
+		return s.Err()
+	}
+
+	switch {
+	case errors.Is(err, configurations.ErrFileNotFound), errors.Is(err, configurations.ErrRevisionNotFound),
+		errors.Is(err, configurations.ErrSchemaNotFound), errors.Is(err, users.ErrServiceAccountNotFound), errors.Is(err, users.ErrAPIKeyNotFound),
+		errors.Is(err, users.ErrUserNotFound), errors.Is(err, users.ErrTeamNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, configurations.ErrFileExists), errors.Is(err, configurations.ErrPathConflict),
+		errors.Is(err, users.ErrServiceAccountExists), errors.Is(err, users.ErrUserExists), errors.Is(err, users.ErrTeamExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, configurations.ErrPreconditionFailed), errors.Is(err, configurations.ErrNamespaceNotEmpty):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, users.ErrPermissionDenied), errors.Is(err, configurations.ErrAccessDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, configurations.ErrWatchOverflow):
+		return status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, configurations.ErrInvalidCursor), errors.Is(err, configurations.ErrInvalidPath),
+		errors.Is(err, configurations.ErrInvalidGrant), errors.Is(err, configurations.ErrInvalidFileType),
+		errors.Is(err, configurations.ErrFileTypeMismatch), errors.Is(err, configurations.ErrInvalidSchema),
+		errors.Is(err, configurations.ErrUnsupportedConversion),
+		errors.Is(err, users.ErrInvalidName), errors.Is(err, users.ErrInvalidRole):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return err
+	}
+}
+
+func revisionToProto(revision *configurations.Revision) *pb.Revision {
+	return &pb.Revision{
+		Revision:   int64(revision.Number),
+		Author:     revision.Author,
+		Hash:       revision.Hash,
+		Size:       revision.Size,
+		FileType:   fileTypeToProto(revision.FileType),
+		CreatedAt:  timestamppb.New(revision.CreatedAt),
+		RollbackOf: int64(revision.RollbackOf),
+	}
+}
+
+// fileTypeFromProto converts a file type sent by a client.
+// FILE_TYPE_UNSPECIFIED leaves the type to the server.
+func fileTypeFromProto(fileType pb.FileType) configurations.FileType {
+	switch fileType {
+	case pb.FileType_FILE_TYPE_TXT:
+		return configurations.FileTypeTXT
+	case pb.FileType_FILE_TYPE_CSV:
+		return configurations.FileTypeCSV
+	case pb.FileType_FILE_TYPE_JSON:
+		return configurations.FileTypeJSON
+	case pb.FileType_FILE_TYPE_XML:
+		return configurations.FileTypeXML
+	case pb.FileType_FILE_TYPE_YAML:
+		return configurations.FileTypeYAML
+	case pb.FileType_FILE_TYPE_TOML:
+		return configurations.FileTypeTOML
+	case pb.FileType_FILE_TYPE_INI:
+		return configurations.FileTypeINI
+	case pb.FileType_FILE_TYPE_PROPERTIES:
+		return configurations.FileTypeProperties
+	case pb.FileType_FILE_TYPE_HCL:
+		return configurations.FileTypeHCL
+	case pb.FileType_FILE_TYPE_ENV:
+		return configurations.FileTypeEnv
+	case pb.FileType_FILE_TYPE_UNSPECIFIED:
+		return configurations.FileTypeUnspecified
+	default:
+		// Unknown values are rejected by the configuration manager
+		return configurations.FileType(-1)
+	}
+}
+
+func fileTypeToProto(fileType configurations.FileType) pb.FileType {
+	switch fileType {
+	case configurations.FileTypeTXT:
+		return pb.FileType_FILE_TYPE_TXT
+	case configurations.FileTypeCSV:
+		return pb.FileType_FILE_TYPE_CSV
+	case configurations.FileTypeJSON:
+		return pb.FileType_FILE_TYPE_JSON
+	case configurations.FileTypeXML:
+		return pb.FileType_FILE_TYPE_XML
+	case configurations.FileTypeYAML:
+		return pb.FileType_FILE_TYPE_YAML
+	case configurations.FileTypeTOML:
+		return pb.FileType_FILE_TYPE_TOML
+	case configurations.FileTypeINI:
+		return pb.FileType_FILE_TYPE_INI
+	case configurations.FileTypeProperties:
+		return pb.FileType_FILE_TYPE_PROPERTIES
+	case configurations.FileTypeHCL:
+		return pb.FileType_FILE_TYPE_HCL
+	case configurations.FileTypeEnv:
+		return pb.FileType_FILE_TYPE_ENV
+	default:
+		return pb.FileType_FILE_TYPE_UNSPECIFIED
+	}
+}
+
 func (s *Server) AddUser(ctx context.Context, req *pb.AddUser) (*emptypb.Empty, error) {
-	err := s.userManager.AddUser(ctx, req.GetUserId(), req.GetEmail(), req.GetName(), req.GetPassword())
+	var err error
+	if users.IdentityFromContext(ctx) == nil {
+		// The interceptor lets calls without credentials through while no
+		// user exists
+		err = s.userManager.AddFirstUser(ctx, req.GetUserId(), req.GetEmail(), req.GetName(), req.GetPassword())
+	} else {
+		err = s.userManager.AddUser(ctx, req.GetUserId(), req.GetEmail(), req.GetName(), req.GetPassword(), roleFromProto(req.GetRole()))
+	}
 	if err != nil {
-		return nil, err
+		return nil, toStatus(err)
 	}
 
 	return &emptypb.Empty{}, nil
 }
 
 func (s *Server) UpdateUser(ctx context.Context, req *pb.UpdateUser) (*emptypb.Empty, error) {
-	err := s.userManager.UpdateUser(ctx, req.GetUserId(), req.GetEmail(), req.GetName(), req.GetPassword())
+	role := roleFromProto(req.GetRole())
+
+	// Users may update themselves except for their role
+	identity := users.IdentityFromContext(ctx)
+	if err := users.Authorize(identity, users.PermissionManageUsers); err != nil {
+		if identity == nil || identity.ServiceAccount != "" || identity.UserID != req.GetUserId() || role != "" {
+			return nil, toStatus(err)
+		}
+	}
+
+	err := s.userManager.UpdateUser(ctx, req.GetUserId(), req.GetEmail(), req.GetName(), req.GetPassword(), role)
 	if err != nil {
-		return nil, err
+		return nil, toStatus(err)
 	}
 
 	return &emptypb.Empty{}, nil
 }
 
 func (s *Server) DeleteUser(ctx context.Context, req *pb.DeleteUser) (*emptypb.Empty, error) {
-	err := s.userManager.DeleteUser(ctx, req.GetUserId())
-	if err != nil {
-		return nil, err
+	// Files and grants left behind would pass to the next user of that ID
+	if err := s.configManager.CheckNamespaceEmpty(ctx, req.GetUserId()); err != nil {
+		return nil, toStatus(err)
+	}
+
+	if err := s.userManager.DeleteUser(ctx, req.GetUserId()); err != nil {
+		return nil, toStatus(err)
+	}
+
+	if err := s.configManager.RevokeGrants(ctx, req.GetUserId()); err != nil {
+		return nil, toStatus(err)
 	}
 
 	return &emptypb.Empty{}, nil
-}
\ No newline at end of file
+}
+
+func roleFromProto(role pb.Role) users.Role {
+	switch role {
+	case pb.Role_ROLE_VIEWER:
+		return users.RoleViewer
+	case pb.Role_ROLE_EDITOR:
+		return users.RoleEditor
+	case pb.Role_ROLE_ADMIN:
+		return users.RoleAdmin
+	default:
+		return ""
+	}
+}
+
+func roleToProto(role users.Role) pb.Role {
+	switch role {
+	case users.RoleViewer:
+		return pb.Role_ROLE_VIEWER
+	case users.RoleEditor:
+		return pb.Role_ROLE_EDITOR
+	case users.RoleAdmin:
+		return pb.Role_ROLE_ADMIN
+	default:
+		return pb.Role_ROLE_UNSPECIFIED
+	}
+}
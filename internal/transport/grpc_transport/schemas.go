@@ -0,0 +1,55 @@
+package grpc_transport
+
+import (
+	"context"
+
+	"github.com/yash3004/config_server/configurations"
+	pb "github.com/yash3004/config_server/generated/protobuf/configpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) SetSchema(ctx context.Context, req *pb.SetSchema) (*pb.Schema, error) {
+	caller := callerFromContext(ctx)
+
+	schema, err := s.configManager.SetSchema(ctx, caller, req.GetOwner(), req.GetPattern(), []byte(req.GetSchema()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return schemaToProto(schema), nil
+}
+
+func (s *Server) DeleteSchema(ctx context.Context, req *pb.DeleteSchema) (*emptypb.Empty, error) {
+	caller := callerFromContext(ctx)
+
+	if err := s.configManager.DeleteSchema(ctx, caller, req.GetOwner(), req.GetPattern()); err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) ListSchemas(ctx context.Context, req *pb.ListSchemas) (*pb.ListSchemasResponse, error) {
+	caller := callerFromContext(ctx)
+
+	schemas, err := s.configManager.ListSchemas(ctx, caller, req.GetOwner())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	response := &pb.ListSchemasResponse{}
+	for _, schema := range schemas {
+		response.Schemas = append(response.Schemas, schemaToProto(schema))
+	}
+	return response, nil
+}
+
+func schemaToProto(schema *configurations.Schema) *pb.Schema {
+	return &pb.Schema{
+		Pattern:   schema.Pattern,
+		Schema:    string(schema.Schema),
+		Author:    schema.Author,
+		UpdatedAt: timestamppb.New(schema.UpdatedAt),
+	}
+}
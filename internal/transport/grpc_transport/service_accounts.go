@@ -0,0 +1,134 @@
+package grpc_transport
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/yash3004/config_server/generated/protobuf/configpb"
+	"github.com/yash3004/config_server/users"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) CreateServiceAccount(ctx context.Context, req *pb.CreateServiceAccount) (*pb.ServiceAccount, error) {
+	userID, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := s.userManager.CreateServiceAccount(ctx, userID, req.GetName(), req.GetDescription(), roleFromProto(req.GetRole()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return serviceAccountToProto(account), nil
+}
+
+func (s *Server) ListServiceAccounts(ctx context.Context, req *emptypb.Empty) (*pb.ListServiceAccountsResponse, error) {
+	userID, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := s.userManager.ListServiceAccounts(ctx, userID)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	response := &pb.ListServiceAccountsResponse{}
+	for _, account := range accounts {
+		response.ServiceAccounts = append(response.ServiceAccounts, serviceAccountToProto(account))
+	}
+	return response, nil
+}
+
+func (s *Server) DeleteServiceAccount(ctx context.Context, req *pb.DeleteServiceAccount) (*emptypb.Empty, error) {
+	userID, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.userManager.DeleteServiceAccount(ctx, userID, req.GetName()); err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) CreateApiKey(ctx context.Context, req *pb.CreateApiKey) (*pb.CreateApiKeyResponse, error) {
+	userID, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var expiresAt time.Time
+	if req.GetExpiresAt() != nil {
+		expiresAt = req.GetExpiresAt().AsTime()
+	}
+
+	key, apiKey, err := s.userManager.CreateAPIKey(ctx, userID, req.GetServiceAccount(), req.GetName(), expiresAt)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.CreateApiKeyResponse{
+		Key:    key,
+		ApiKey: apiKeyToProto(apiKey),
+	}, nil
+}
+
+func (s *Server) ListApiKeys(ctx context.Context, req *pb.ListApiKeys) (*pb.ListApiKeysResponse, error) {
+	userID, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := s.userManager.ListAPIKeys(ctx, userID, req.GetServiceAccount())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	response := &pb.ListApiKeysResponse{}
+	for _, key := range keys {
+		response.ApiKeys = append(response.ApiKeys, apiKeyToProto(key))
+	}
+	return response, nil
+}
+
+func (s *Server) RevokeApiKey(ctx context.Context, req *pb.RevokeApiKey) (*emptypb.Empty, error) {
+	userID, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.userManager.RevokeAPIKey(ctx, userID, req.GetServiceAccount(), req.GetKeyId()); err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func serviceAccountToProto(account *users.ServiceAccount) *pb.ServiceAccount {
+	return &pb.ServiceAccount{
+		Name:        account.Name,
+		Description: account.Description,
+		CreatedAt:   timestamppb.New(account.CreatedAt),
+		Role:        roleToProto(account.Role),
+	}
+}
+
+func apiKeyToProto(key *users.APIKey) *pb.ApiKey {
+	apiKey := &pb.ApiKey{
+		KeyId:          key.KeyID,
+		ServiceAccount: key.ServiceAccount,
+		Name:           key.Name,
+		CreatedAt:      timestamppb.New(key.CreatedAt),
+	}
+	if !key.ExpiresAt.IsZero() {
+		apiKey.ExpiresAt = timestamppb.New(key.ExpiresAt)
+	}
+	if !key.LastUsedAt.IsZero() {
+		apiKey.LastUsedAt = timestamppb.New(key.LastUsedAt)
+	}
+	return apiKey
+}
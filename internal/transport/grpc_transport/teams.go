@@ -0,0 +1,142 @@
+package grpc_transport
+
+import (
+	"context"
+
+	"github.com/yash3004/config_server/configurations"
+	pb "github.com/yash3004/config_server/generated/protobuf/configpb"
+	"github.com/yash3004/config_server/users"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) CreateTeam(ctx context.Context, req *pb.CreateTeam) (*pb.Team, error) {
+	userID, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	team, err := s.userManager.CreateTeam(ctx, userID, req.GetName(), req.GetDescription())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return teamToProto(team), nil
+}
+
+func (s *Server) ListTeams(ctx context.Context, req *emptypb.Empty) (*pb.ListTeamsResponse, error) {
+	teams, err := s.userManager.ListTeams(ctx, users.UserIDFromContext(ctx))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	response := &pb.ListTeamsResponse{}
+	for _, team := range teams {
+		response.Teams = append(response.Teams, teamToProto(team))
+	}
+	return response, nil
+}
+
+func (s *Server) DeleteTeam(ctx context.Context, req *pb.DeleteTeam) (*emptypb.Empty, error) {
+	identity := users.IdentityFromContext(ctx)
+
+	// Files and grants left behind would pass to the next team of that name
+	if _, err := s.userManager.AuthorizeTeam(ctx, identity, req.GetName()); err != nil {
+		return nil, toStatus(err)
+	}
+	if err := s.configManager.CheckNamespaceEmpty(ctx, configurations.TeamNamespace(req.GetName())); err != nil {
+		return nil, toStatus(err)
+	}
+
+	if err := s.userManager.DeleteTeam(ctx, identity, req.GetName()); err != nil {
+		return nil, toStatus(err)
+	}
+	if err := s.configManager.RevokeGrants(ctx, configurations.TeamNamespace(req.GetName())); err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) AddTeamMember(ctx context.Context, req *pb.TeamMember) (*emptypb.Empty, error) {
+	identity := users.IdentityFromContext(ctx)
+
+	if err := s.userManager.AddTeamMember(ctx, identity, req.GetTeam(), req.GetUserId()); err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) RemoveTeamMember(ctx context.Context, req *pb.TeamMember) (*emptypb.Empty, error) {
+	identity := users.IdentityFromContext(ctx)
+
+	if err := s.userManager.RemoveTeamMember(ctx, identity, req.GetTeam(), req.GetUserId()); err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) ListGrants(ctx context.Context, req *pb.ListGrants) (*pb.ListGrantsResponse, error) {
+	caller := callerFromContext(ctx)
+
+	grants, err := s.configManager.ListGrants(ctx, caller, req.GetOwner(), req.GetFilename())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	response := &pb.ListGrantsResponse{}
+	for _, grant := range grants {
+		response.Grants = append(response.Grants, &pb.Grant{
+			Grantee: grant.Grantee,
+			Access:  accessToProto(grant.Access),
+		})
+	}
+	return response, nil
+}
+
+func (s *Server) SetGrant(ctx context.Context, req *pb.SetGrant) (*emptypb.Empty, error) {
+	caller := callerFromContext(ctx)
+
+	grant := configurations.Grant{
+		Grantee: req.GetGrant().GetGrantee(),
+		Access:  accessFromProto(req.GetGrant().GetAccess()),
+	}
+	if err := s.configManager.SetGrant(ctx, caller, req.GetOwner(), req.GetFilename(), grant); err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func teamToProto(team *users.Team) *pb.Team {
+	return &pb.Team{
+		Name:        team.Name,
+		Description: team.Description,
+		Members:     team.Members,
+		CreatedAt:   timestamppb.New(team.CreatedAt),
+	}
+}
+
+func accessFromProto(access pb.Access) configurations.Access {
+	switch access {
+	case pb.Access_ACCESS_READ:
+		return configurations.AccessRead
+	case pb.Access_ACCESS_WRITE:
+		return configurations.AccessWrite
+	default:
+		return configurations.AccessNone
+	}
+}
+
+func accessToProto(access configurations.Access) pb.Access {
+	switch access {
+	case configurations.AccessRead:
+		return pb.Access_ACCESS_READ
+	case configurations.AccessWrite:
+		return pb.Access_ACCESS_WRITE
+	default:
+		return pb.Access_ACCESS_NONE
+	}
+}
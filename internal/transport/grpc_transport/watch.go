@@ -0,0 +1,49 @@
+package grpc_transport
+
+import (
+	"github.com/yash3004/config_server/configurations"
+	pb "github.com/yash3004/config_server/generated/protobuf/configpb"
+)
+
+// WatchConfig streams the changes of a file or of the files below a prefix
+// until the client cancels the call. Clients that fall behind receive
+// codes.Aborted and resume with the last revision they received.
+func (s *Server) WatchConfig(req *pb.WatchConfig, stream pb.ConfigService_WatchConfigServer) error {
+	ctx := stream.Context()
+	caller := callerFromContext(ctx)
+
+	opts := configurations.WatchOptions{
+		Filename:      req.GetFilename(),
+		Prefix:        req.GetPrefix(),
+		SinceRevision: int(req.GetSinceRevision()),
+	}
+	err := s.configManager.Watch(ctx, caller, req.GetOwner(), opts, func(event *configurations.Event) error {
+		return stream.Send(eventToProto(event))
+	})
+	if ctx.Err() != nil {
+		// The client went away
+		return nil
+	}
+	return toStatus(err)
+}
+
+func eventToProto(event *configurations.Event) *pb.ConfigEvent {
+	response := &pb.ConfigEvent{
+		Owner:    event.Namespace,
+		Filename: event.Filename,
+		Data:     event.Data,
+	}
+	switch event.Type {
+	case configurations.EventUpdated:
+		response.Type = pb.EventType_EVENT_TYPE_UPDATED
+	case configurations.EventDeleted:
+		response.Type = pb.EventType_EVENT_TYPE_DELETED
+	case configurations.EventSynced:
+		response.Type = pb.EventType_EVENT_TYPE_SYNCED
+	}
+	if event.Revision != nil {
+		response.FileType = fileTypeToProto(event.Revision.FileType)
+		response.Revision = revisionToProto(event.Revision)
+	}
+	return response
+}
@@ -0,0 +1,192 @@
+package http_transport
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/yash3004/config_server/configurations"
+	"github.com/yash3004/config_server/users"
+)
+
+// maxCredentialsBody caps how much of a request body is buffered to look
+// for legacy credentials
+const maxCredentialsBody = 10 << 20
+
+type TokenRequest struct {
+	UserID   string `json:"user_id"`
+	Password string `json:"password"`
+}
+
+type TokenResponse struct {
+	Token     string    `json:"token"`
+	TokenType string    `json:"token_type"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// authenticate is middleware that authenticates a request and stores the
+// identity of the caller in its context
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return s.authenticateWith(true, next)
+}
+
+// authenticateToken is like authenticate but only accepts bearer
+// credentials, for requests whose body describes another user
+func (s *Server) authenticateToken(next http.Handler) http.Handler {
+	return s.authenticateWith(false, next)
+}
+
+func (s *Server) authenticateWith(allowPassword bool, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		identity, err := s.authenticateRequest(r, allowPassword)
+		if err != nil {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="config_server"`)
+			http.Error(w, "Authentication failed", http.StatusUnauthorized)
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(users.ContextWithIdentity(r.Context(), identity)))
+	})
+}
+
+// authenticateRequest returns the identity of the caller from its bearer
+// token or API key. If allowPassword is set and the server accepts legacy
+// passwords, requests without one may still send user_id and password in
+// the JSON body.
+func (s *Server) authenticateRequest(r *http.Request, allowPassword bool) (*users.Identity, error) {
+	if token, ok := bearerToken(r); ok {
+		return s.userManager.VerifyBearer(r.Context(), token)
+	}
+	if !allowPassword || !s.legacyPasswords {
+		return nil, errors.New("missing bearer token")
+	}
+
+	creds, err := legacyCredentials(r)
+	if err != nil {
+		return nil, err
+	}
+	return s.userManager.AuthenticatePassword(r.Context(), creds.UserID, creds.Password)
+}
+
+// authorize wraps a handler of an authenticated route with a check that the
+// role of the caller grants permission
+func authorize(permission users.Permission, next http.HandlerFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := users.Authorize(users.IdentityFromContext(r.Context()), permission); err != nil {
+			http.Error(w, "Permission denied", http.StatusForbidden)
+			return
+		}
+		next(w, r)
+	})
+}
+
+// allowFirstUser serves first with no credentials while no user exists, so
+// the first user can be added, and hands requests to next afterwards
+func (s *Server) allowFirstUser(first http.HandlerFunc, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hasUsers, err := s.userManager.HasUsers(r.Context())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if !hasUsers {
+			first(w, r)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// bearerToken returns the token of the Authorization header of a request
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, found := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return "", false
+	}
+	return strings.TrimSpace(token), true
+}
+
+// legacyCredentials reads user_id and password from the JSON body, which is
+// left intact for the handler. Passwords in the query string are never
+// accepted since they end up in access logs.
+func legacyCredentials(r *http.Request) (AuthRequest, error) {
+	var creds AuthRequest
+	if r.Body == nil {
+		return creds, errors.New("missing credentials")
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxCredentialsBody))
+	if err != nil {
+		return creds, err
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	if err := json.Unmarshal(body, &creds); err != nil || creds.Password == "" {
+		return creds, errors.New("missing credentials")
+	}
+	return creds, nil
+}
+
+// requestCaller returns the caller authenticated by the middleware together
+// with its teams
+func requestCaller(r *http.Request) configurations.Caller {
+	identity := users.IdentityFromContext(r.Context())
+	if identity == nil {
+		return configurations.Caller{}
+	}
+	return configurations.Caller{UserID: identity.UserID, Teams: identity.Teams}
+}
+
+// requireUser returns the ID of the calling user, responding with 403 and
+// false when the caller authenticated as a service account
+func requireUser(w http.ResponseWriter, r *http.Request) (string, bool) {
+	identity := users.IdentityFromContext(r.Context())
+	if identity == nil || identity.ServiceAccount != "" {
+		http.Error(w, "Only users may call this endpoint", http.StatusForbidden)
+		return "", false
+	}
+	return identity.UserID, true
+}
+
+// issueToken handles POST /auth/token
+func (s *Server) issueToken(w http.ResponseWriter, r *http.Request) {
+	var req TokenRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request", http.StatusBadRequest)
+		return
+	}
+
+	token, expiresAt, err := s.userManager.IssueToken(r.Context(), req.UserID, req.Password)
+	if err != nil {
+		http.Error(w, "Authentication failed", http.StatusUnauthorized)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	json.NewEncoder(w).Encode(TokenResponse{
+		Token:     token,
+		TokenType: "Bearer",
+		ExpiresAt: expiresAt,
+	})
+}
+
+// revokeToken handles DELETE /auth/token
+func (s *Server) revokeToken(w http.ResponseWriter, r *http.Request) {
+	token, ok := bearerToken(r)
+	if !ok {
+		http.Error(w, "Bearer token is required", http.StatusBadRequest)
+		return
+	}
+
+	err := s.userManager.RevokeToken(r.Context(), token)
+	if err != nil && !errors.Is(err, users.ErrInvalidToken) {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,48 @@
+package http_transport
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/yash3004/config_server/users"
+)
+
+func TestAuthorize(t *testing.T) {
+	viewer := &users.Identity{UserID: "v", Role: users.RoleViewer}
+	editor := &users.Identity{UserID: "e", Role: users.RoleEditor}
+	admin := &users.Identity{UserID: "a", Role: users.RoleAdmin}
+	tests := []struct {
+		name       string
+		identity   *users.Identity
+		permission users.Permission
+		wantStatus int
+	}{
+		{name: "viewer reads", identity: viewer, permission: users.PermissionReadConfigs, wantStatus: http.StatusOK},
+		{name: "viewer writes", identity: viewer, permission: users.PermissionWriteConfigs, wantStatus: http.StatusForbidden},
+		{name: "viewer manages teams", identity: viewer, permission: users.PermissionManageTeams, wantStatus: http.StatusForbidden},
+		{name: "editor writes", identity: editor, permission: users.PermissionWriteConfigs, wantStatus: http.StatusOK},
+		{name: "editor manages users", identity: editor, permission: users.PermissionManageUsers, wantStatus: http.StatusForbidden},
+		{name: "admin manages users", identity: admin, permission: users.PermissionManageUsers, wantStatus: http.StatusOK},
+		{name: "no identity", permission: users.PermissionReadConfigs, wantStatus: http.StatusForbidden},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			called := false
+			handler := authorize(test.permission, func(w http.ResponseWriter, r *http.Request) {
+				called = true
+			})
+
+			r := httptest.NewRequest(http.MethodPost, "/config", nil)
+			if test.identity != nil {
+				r = r.WithContext(users.ContextWithIdentity(r.Context(), test.identity))
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+
+			if w.Code != test.wantStatus || called != (test.wantStatus == http.StatusOK) {
+				t.Errorf("status %d, handler called %v; want status %d", w.Code, called, test.wantStatus)
+			}
+		})
+	}
+}
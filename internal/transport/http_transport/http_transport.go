@@ -2,7 +2,12 @@ package http_transport
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/yash3004/config_server/configurations"
@@ -14,14 +19,19 @@ type Server struct {
 	userManager   *users.UserManager
 	configManager *configurations.ConfigManager
 	router        *mux.Router
+	// legacyPasswords accepts user_id and password in the JSON body of
+	// requests without a bearer token
+	legacyPasswords bool
 }
 
-// NewServer creates a new HTTP server
-func NewServer(userManager *users.UserManager, configManager *configurations.ConfigManager) *Server {
+// NewServer creates a new HTTP server. legacyPasswords keeps accepting
+// passwords in request bodies for clients that do not use tokens yet.
+func NewServer(userManager *users.UserManager, configManager *configurations.ConfigManager, legacyPasswords bool) *Server {
 	s := &Server{
-		userManager:   userManager,
-		configManager: configManager,
-		router:        mux.NewRouter(),
+		userManager:     userManager,
+		configManager:   configManager,
+		router:          mux.NewRouter(),
+		legacyPasswords: legacyPasswords,
 	}
 	s.setupRoutes()
 	return s
@@ -29,16 +39,55 @@ func NewServer(userManager *users.UserManager, configManager *configurations.Con
 
 // setupRoutes configures the HTTP routes
 func (s *Server) setupRoutes() {
-	// Configuration routes
-	s.router.HandleFunc("/config", s.addConfig).Methods("POST")
-	s.router.HandleFunc("/config", s.updateConfig).Methods("PUT")
-	s.router.HandleFunc("/config", s.deleteConfig).Methods("DELETE")
-	s.router.HandleFunc("/config", s.getConfig).Methods("GET")
+	// Authentication routes
+	s.router.HandleFunc("/auth/token", s.issueToken).Methods("POST")
+	s.router.HandleFunc("/auth/token", s.revokeToken).Methods("DELETE")
 
 	// User routes
-	s.router.HandleFunc("/user", s.addUser).Methods("POST")
-	s.router.HandleFunc("/user", s.updateUser).Methods("PUT")
-	s.router.HandleFunc("/user/{userID}", s.deleteUser).Methods("DELETE")
+	s.router.Handle("/user", s.allowFirstUser(s.addFirstUser,
+		s.authenticateToken(authorize(users.PermissionManageUsers, s.addUser)))).Methods("POST")
+	s.router.Handle("/user", s.authenticateToken(http.HandlerFunc(s.updateUser))).Methods("PUT")
+	s.router.Handle("/user/{userID}", s.authenticateToken(authorize(users.PermissionManageUsers, s.deleteUser))).Methods("DELETE")
+
+	// Authenticated routes
+	authed := s.router.NewRoute().Subrouter()
+	authed.Use(s.authenticate)
+
+	// Configuration routes
+	authed.Handle("/config", authorize(users.PermissionWriteConfigs, s.addConfig)).Methods("POST")
+	authed.Handle("/config", authorize(users.PermissionWriteConfigs, s.updateConfig)).Methods("PUT")
+	authed.Handle("/config", authorize(users.PermissionWriteConfigs, s.deleteConfig)).Methods("DELETE")
+	authed.Handle("/config", authorize(users.PermissionReadConfigs, s.getConfig)).Methods("GET")
+	authed.Handle("/configs", authorize(users.PermissionReadConfigs, s.listConfigs)).Methods("GET")
+	authed.Handle("/directory", authorize(users.PermissionReadConfigs, s.listDirectory)).Methods("GET")
+	authed.Handle("/directory", authorize(users.PermissionWriteConfigs, s.deleteDirectory)).Methods("DELETE")
+	authed.Handle("/config/move", authorize(users.PermissionWriteConfigs, s.moveConfig)).Methods("POST")
+	authed.Handle("/config/revisions", authorize(users.PermissionReadConfigs, s.listRevisions)).Methods("GET")
+	authed.Handle("/config/revisions/{revision:[0-9]+}", authorize(users.PermissionReadConfigs, s.getRevision)).Methods("GET")
+	authed.Handle("/config/rollback", authorize(users.PermissionWriteConfigs, s.rollbackConfig)).Methods("POST")
+	authed.Handle("/config/diff", authorize(users.PermissionReadConfigs, s.diffConfig)).Methods("GET")
+	authed.Handle("/config/watch", authorize(users.PermissionReadConfigs, s.watchConfig)).Methods("GET")
+
+	authed.Handle("/config/grants", authorize(users.PermissionReadConfigs, s.listGrants)).Methods("GET")
+	authed.Handle("/config/grants", authorize(users.PermissionWriteConfigs, s.setGrant)).Methods("PUT")
+	authed.Handle("/config/schemas", authorize(users.PermissionReadConfigs, s.listSchemas)).Methods("GET")
+	authed.Handle("/config/schemas", authorize(users.PermissionWriteConfigs, s.setSchema)).Methods("PUT")
+	authed.Handle("/config/schemas", authorize(users.PermissionWriteConfigs, s.deleteSchema)).Methods("DELETE")
+
+	// Team routes
+	authed.Handle("/teams", authorize(users.PermissionManageTeams, s.createTeam)).Methods("POST")
+	authed.Handle("/teams", authorize(users.PermissionReadConfigs, s.listTeams)).Methods("GET")
+	authed.Handle("/teams/{name}", authorize(users.PermissionManageTeams, s.deleteTeam)).Methods("DELETE")
+	authed.Handle("/teams/{name}/members", authorize(users.PermissionManageTeams, s.addTeamMember)).Methods("POST")
+	authed.Handle("/teams/{name}/members/{userID}", authorize(users.PermissionManageTeams, s.removeTeamMember)).Methods("DELETE")
+
+	// Service account routes
+	authed.Handle("/service-accounts", authorize(users.PermissionManageServiceAccounts, s.createServiceAccount)).Methods("POST")
+	authed.Handle("/service-accounts", authorize(users.PermissionManageServiceAccounts, s.listServiceAccounts)).Methods("GET")
+	authed.Handle("/service-accounts/{name}", authorize(users.PermissionManageServiceAccounts, s.deleteServiceAccount)).Methods("DELETE")
+	authed.Handle("/service-accounts/{name}/keys", authorize(users.PermissionManageServiceAccounts, s.createAPIKey)).Methods("POST")
+	authed.Handle("/service-accounts/{name}/keys", authorize(users.PermissionManageServiceAccounts, s.listAPIKeys)).Methods("GET")
+	authed.Handle("/service-accounts/{name}/keys/{keyID}", authorize(users.PermissionManageServiceAccounts, s.revokeAPIKey)).Methods("DELETE")
 }
 
 // StartHTTPServer starts the HTTP server
@@ -47,6 +96,9 @@ func (s *Server) StartHTTPServer(address string) error {
 }
 
 // Request and response types
+
+// AuthRequest holds the credentials of clients that authenticate without a
+// bearer token
 type AuthRequest struct {
 	UserID   string `json:"user_id"`
 	Password string `json:"password"`
@@ -54,9 +106,29 @@ type AuthRequest struct {
 
 type ConfigRequest struct {
 	AuthRequest
+	// Owner is the namespace of the file: empty for the caller's own, the
+	// ID of another user or "@<team>" for a team
+	Owner    string `json:"owner,omitempty"`
 	Filename string `json:"filename"`
-	FileType int    `json:"file_type"`
-	Data     []byte `json:"data"`
+	// FileType is "txt", "csv", "json", "xml", "yaml", "toml", "ini",
+	// "properties", "hcl" or "env". When empty it is derived from the file
+	// name or content, or kept on updates.
+	FileType configurations.FileType `json:"file_type"`
+	Data     []byte                  `json:"data"`
+}
+
+type RollbackRequest struct {
+	AuthRequest
+	Owner    string `json:"owner,omitempty"`
+	Filename string `json:"filename"`
+	Revision int    `json:"revision"`
+}
+
+type MoveRequest struct {
+	AuthRequest
+	Owner string `json:"owner,omitempty"`
+	From  string `json:"from"`
+	To    string `json:"to"`
 }
 
 type UserRequest struct {
@@ -64,13 +136,193 @@ type UserRequest struct {
 	Email    string `json:"email"`
 	Name     string `json:"name"`
 	Password string `json:"password"`
+	// Role is one of "admin", "editor" or "viewer"
+	Role string `json:"role,omitempty"`
 }
 
 type ConfigResponse struct {
-	UserID   string `json:"user_id"`
-	Filename string `json:"filename"`
-	FileType int    `json:"file_type"`
-	Data     []byte `json:"data"`
+	UserID   string                  `json:"user_id"`
+	Filename string                  `json:"filename"`
+	FileType configurations.FileType `json:"file_type"`
+	Data     []byte                  `json:"data"`
+	Revision int                     `json:"revision,omitempty"`
+	ETag     string                  `json:"etag,omitempty"`
+	// Format is the format Data was converted into, see
+	// configurations.ConvertConfig
+	Format configurations.Format `json:"format,omitempty"`
+}
+
+type ConfigInfo struct {
+	Filename  string                  `json:"filename"`
+	FileType  configurations.FileType `json:"file_type"`
+	Size      int64                   `json:"size"`
+	CreatedAt time.Time               `json:"created_at"`
+	UpdatedAt time.Time               `json:"updated_at"`
+	Revision  int                     `json:"revision"`
+	ETag      string                  `json:"etag"`
+}
+
+type ListConfigsResponse struct {
+	Configs    []ConfigInfo `json:"configs"`
+	NextCursor string       `json:"next_cursor,omitempty"`
+}
+
+type DirectoryEntry struct {
+	Name        string      `json:"name"`
+	Path        string      `json:"path"`
+	IsDirectory bool        `json:"is_directory"`
+	Config      *ConfigInfo `json:"config,omitempty"`
+}
+
+type DeleteDirectoryResponse struct {
+	Deleted int `json:"deleted"`
+}
+
+type RevisionResponse struct {
+	Revision   int                     `json:"revision"`
+	Author     string                  `json:"author"`
+	Hash       string                  `json:"hash"`
+	Size       int64                   `json:"size"`
+	FileType   configurations.FileType `json:"file_type"`
+	CreatedAt  time.Time               `json:"created_at"`
+	RollbackOf int                     `json:"rollback_of,omitempty"`
+}
+
+type ConfigRevisionResponse struct {
+	ConfigResponse
+	Revision RevisionResponse `json:"revision"`
+}
+
+type DiffResponse struct {
+	UserID       string                     `json:"user_id"`
+	Filename     string                     `json:"filename"`
+	FromRevision int                        `json:"from_revision"`
+	ToRevision   int                        `json:"to_revision"`
+	Unified      string                     `json:"unified"`
+	Structural   bool                       `json:"structural"`
+	Changes      []configurations.KeyChange `json:"changes,omitempty"`
+}
+
+func newConfigInfo(file *configurations.ConfigFile) ConfigInfo {
+	return ConfigInfo{
+		Filename:  file.Filename,
+		FileType:  file.FileType,
+		Size:      file.Size,
+		CreatedAt: file.CreatedAt,
+		UpdatedAt: file.UpdatedAt,
+		Revision:  file.Revision,
+		ETag:      file.Hash,
+	}
+}
+
+func newRevisionResponse(revision *configurations.Revision) RevisionResponse {
+	return RevisionResponse{
+		Revision:   revision.Number,
+		Author:     revision.Author,
+		Hash:       revision.Hash,
+		Size:       revision.Size,
+		FileType:   revision.FileType,
+		CreatedAt:  revision.CreatedAt,
+		RollbackOf: revision.RollbackOf,
+	}
+}
+
+// ErrorResponse is the body of errors that carry details
+type ErrorResponse struct {
+	Error      string                           `json:"error"`
+	Details    *configurations.ValidationError  `json:"details,omitempty"`
+	Violations []configurations.SchemaViolation `json:"violations,omitempty"`
+}
+
+// writeError responds with the HTTP status matching a configuration or user
+// error. Invalid content and schema violations are described by a JSON
+// ErrorResponse.
+func writeError(w http.ResponseWriter, err error) {
+	var validationErr *configurations.ValidationError
+	var schemaErr *configurations.SchemaError
+	switch {
+	case errors.As(err, &validationErr):
+		writeErrorResponse(w, ErrorResponse{Error: err.Error(), Details: validationErr})
+		return
+	case errors.As(err, &schemaErr):
+		writeErrorResponse(w, ErrorResponse{Error: err.Error(), Violations: schemaErr.Violations})
+		return
+	}
+
+	code := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, configurations.ErrFileNotFound), errors.Is(err, configurations.ErrRevisionNotFound),
+		errors.Is(err, configurations.ErrSchemaNotFound),
+		errors.Is(err, users.ErrServiceAccountNotFound), errors.Is(err, users.ErrAPIKeyNotFound),
+		errors.Is(err, users.ErrUserNotFound), errors.Is(err, users.ErrTeamNotFound):
+		code = http.StatusNotFound
+	case errors.Is(err, configurations.ErrFileExists), errors.Is(err, configurations.ErrPathConflict),
+		errors.Is(err, configurations.ErrNamespaceNotEmpty), errors.Is(err, users.ErrServiceAccountExists),
+		errors.Is(err, users.ErrUserExists), errors.Is(err, users.ErrTeamExists):
+		code = http.StatusConflict
+	case errors.Is(err, configurations.ErrPreconditionFailed):
+		code = http.StatusPreconditionFailed
+	case errors.Is(err, users.ErrPermissionDenied), errors.Is(err, configurations.ErrAccessDenied):
+		code = http.StatusForbidden
+	case errors.Is(err, configurations.ErrInvalidCursor), errors.Is(err, configurations.ErrInvalidPath),
+		errors.Is(err, configurations.ErrInvalidGrant), errors.Is(err, configurations.ErrInvalidFileType),
+		errors.Is(err, configurations.ErrFileTypeMismatch), errors.Is(err, configurations.ErrInvalidSchema),
+		errors.Is(err, configurations.ErrUnsupportedConversion),
+		errors.Is(err, users.ErrInvalidName), errors.Is(err, users.ErrInvalidRole):
+		code = http.StatusBadRequest
+	}
+	http.Error(w, err.Error(), code)
+}
+
+// writeErrorResponse responds to a request with invalid content
+func writeErrorResponse(w http.ResponseWriter, response ErrorResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	json.NewEncoder(w).Encode(response)
+}
+
+// formatETag quotes a content hash for use as an ETag header
+func formatETag(hash string) string {
+	return `"` + hash + `"`
+}
+
+// parseIfMatch returns the content hashes listed in the If-Match header, or
+// nil when the header is absent or matches any version. If-Match compares
+// ETags strongly, so weak ETags in the list never match.
+func parseIfMatch(r *http.Request) ([]string, error) {
+	header := strings.TrimSpace(strings.Join(r.Header.Values("If-Match"), ","))
+	if header == "" || header == "*" {
+		return nil, nil
+	}
+
+	var etags []string
+	weak := false
+	for {
+		header = strings.TrimLeft(header, " \t,")
+		if header == "" {
+			break
+		}
+		isWeak := strings.HasPrefix(header, "W/")
+		header = strings.TrimPrefix(header, "W/")
+
+		// ETags are quoted and may contain commas
+		var etag string
+		if quoted, ok := strings.CutPrefix(header, `"`); ok {
+			etag, header, _ = strings.Cut(quoted, `"`)
+		} else {
+			etag, header, _ = strings.Cut(header, ",")
+			etag = strings.TrimSpace(etag)
+		}
+		if isWeak {
+			weak = true
+			continue
+		}
+		etags = append(etags, etag)
+	}
+	if len(etags) == 0 && weak {
+		return nil, fmt.Errorf("%w: weak ETags cannot match", configurations.ErrPreconditionFailed)
+	}
+	return etags, nil
 }
 
 // addConfig handles POST /config
@@ -81,20 +333,14 @@ func (s *Server) addConfig(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Authenticate user
-	authenticated, err := s.userManager.AuthenticateUser(r.Context(), req.UserID, req.Password)
-	if err != nil || !authenticated {
-		http.Error(w, "Authentication failed", http.StatusUnauthorized)
-		return
-	}
-
 	// Add config
-	err = s.configManager.AddConfig(r.Context(), req.UserID, req.Filename, req.FileType, req.Data)
+	revision, err := s.configManager.AddConfig(r.Context(), requestCaller(r), req.Owner, req.Filename, req.FileType, req.Data)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeError(w, err)
 		return
 	}
 
+	w.Header().Set("ETag", formatETag(revision.Hash))
 	w.WriteHeader(http.StatusCreated)
 }
 
@@ -106,48 +352,42 @@ func (s *Server) updateConfig(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Authenticate user
-	authenticated, err := s.userManager.AuthenticateUser(r.Context(), req.UserID, req.Password)
-	if err != nil || !authenticated {
-		http.Error(w, "Authentication failed", http.StatusUnauthorized)
+	// Update config
+	etags, err := parseIfMatch(r)
+	if err != nil {
+		writeError(w, err)
 		return
 	}
-
-	// Update config
-	err = s.configManager.UpdateConfig(r.Context(), req.UserID, req.Filename, req.FileType, req.Data)
+	cond := configurations.Precondition{ETags: etags}
+	revision, err := s.configManager.UpdateConfig(r.Context(), requestCaller(r), req.Owner, req.Filename, req.FileType, req.Data, cond)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeError(w, err)
 		return
 	}
 
+	w.Header().Set("ETag", formatETag(revision.Hash))
 	w.WriteHeader(http.StatusOK)
 }
 
 // deleteConfig handles DELETE /config
 func (s *Server) deleteConfig(w http.ResponseWriter, r *http.Request) {
-	var req AuthRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, "Invalid request", http.StatusBadRequest)
-		return
-	}
-
-	filename := r.URL.Query().Get("filename")
+	query := r.URL.Query()
+	filename := query.Get("filename")
 	if filename == "" {
 		http.Error(w, "Filename is required", http.StatusBadRequest)
 		return
 	}
 
-	// Authenticate user
-	authenticated, err := s.userManager.AuthenticateUser(r.Context(), req.UserID, req.Password)
-	if err != nil || !authenticated {
-		http.Error(w, "Authentication failed", http.StatusUnauthorized)
+	// Delete config
+	etags, err := parseIfMatch(r)
+	if err != nil {
+		writeError(w, err)
 		return
 	}
-
-	// Delete config
-	err = s.configManager.DeleteConfig(r.Context(), req.UserID, filename)
+	cond := configurations.Precondition{ETags: etags}
+	err = s.configManager.DeleteConfig(r.Context(), requestCaller(r), query.Get("owner"), filename, cond)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeError(w, err)
 		return
 	}
 
@@ -156,34 +396,270 @@ func (s *Server) deleteConfig(w http.ResponseWriter, r *http.Request) {
 
 // getConfig handles GET /config
 func (s *Server) getConfig(w http.ResponseWriter, r *http.Request) {
-	userID := r.URL.Query().Get("user_id")
-	password := r.URL.Query().Get("password")
-	filename := r.URL.Query().Get("filename")
+	query := r.URL.Query()
+	filename := query.Get("filename")
 
-	if userID == "" || password == "" || filename == "" {
+	if filename == "" {
 		http.Error(w, "Missing required parameters", http.StatusBadRequest)
 		return
 	}
 
-	// Authenticate user
-	authenticated, err := s.userManager.AuthenticateUser(r.Context(), userID, password)
-	if err != nil || !authenticated {
-		http.Error(w, "Authentication failed", http.StatusUnauthorized)
+	format, err := configurations.ParseFormat(query.Get("format"))
+	if err != nil {
+		writeError(w, err)
 		return
 	}
 
 	// Get config
-	data, fileType, err := s.configManager.GetConfig(r.Context(), userID, filename)
+	data, file, err := s.configManager.GetConfig(r.Context(), requestCaller(r), query.Get("owner"), filename)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeError(w, err)
+		return
+	}
+	if data, err = configurations.ConvertConfig(data, file.FileType, format); err != nil {
+		writeError(w, err)
 		return
 	}
 
 	response := ConfigResponse{
-		UserID:   userID,
+		UserID:   file.UserID,
 		Filename: filename,
-		FileType: fileType,
+		FileType: file.FileType,
 		Data:     data,
+		Revision: file.Revision,
+		ETag:     file.Hash,
+		Format:   format,
+	}
+
+	w.Header().Set("ETag", formatETag(file.Hash))
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// listConfigs handles GET /configs
+func (s *Server) listConfigs(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	limit := 0
+	if query.Get("limit") != "" {
+		var err error
+		if limit, err = strconv.Atoi(query.Get("limit")); err != nil {
+			http.Error(w, "Invalid limit", http.StatusBadRequest)
+			return
+		}
+	}
+
+	files, next, err := s.configManager.ListConfigs(r.Context(), requestCaller(r), query.Get("owner"), query.Get("prefix"), query.Get("cursor"), limit)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	response := ListConfigsResponse{
+		Configs:    make([]ConfigInfo, 0, len(files)),
+		NextCursor: next,
+	}
+	for _, file := range files {
+		response.Configs = append(response.Configs, newConfigInfo(file))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// listDirectory handles GET /directory
+func (s *Server) listDirectory(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	entries, err := s.configManager.ListDir(r.Context(), requestCaller(r), query.Get("owner"), query.Get("path"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	response := make([]DirectoryEntry, 0, len(entries))
+	for _, entry := range entries {
+		dirEntry := DirectoryEntry{
+			Name:        entry.Name,
+			Path:        entry.Path,
+			IsDirectory: entry.IsDir,
+		}
+		if entry.File != nil {
+			info := newConfigInfo(entry.File)
+			dirEntry.Config = &info
+		}
+		response = append(response, dirEntry)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// deleteDirectory handles DELETE /directory
+func (s *Server) deleteDirectory(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	path := query.Get("path")
+	if path == "" {
+		http.Error(w, "Path is required", http.StatusBadRequest)
+		return
+	}
+
+	deleted, err := s.configManager.DeleteDir(r.Context(), requestCaller(r), query.Get("owner"), path)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(DeleteDirectoryResponse{Deleted: deleted})
+}
+
+// moveConfig handles POST /config/move
+func (s *Server) moveConfig(w http.ResponseWriter, r *http.Request) {
+	var req MoveRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request", http.StatusBadRequest)
+		return
+	}
+
+	if req.From == "" || req.To == "" {
+		http.Error(w, "From and to are required", http.StatusBadRequest)
+		return
+	}
+
+	err := s.configManager.MoveConfig(r.Context(), requestCaller(r), req.Owner, req.From, req.To)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// listRevisions handles GET /config/revisions
+func (s *Server) listRevisions(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	filename := query.Get("filename")
+
+	if filename == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	revisions, err := s.configManager.ListRevisions(r.Context(), requestCaller(r), query.Get("owner"), filename)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	response := make([]RevisionResponse, 0, len(revisions))
+	for _, revision := range revisions {
+		response = append(response, newRevisionResponse(revision))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// getRevision handles GET /config/revisions/{revision}
+func (s *Server) getRevision(w http.ResponseWriter, r *http.Request) {
+	caller := requestCaller(r)
+	query := r.URL.Query()
+	filename := query.Get("filename")
+
+	if filename == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	number, err := strconv.Atoi(mux.Vars(r)["revision"])
+	if err != nil {
+		http.Error(w, "Invalid revision", http.StatusBadRequest)
+		return
+	}
+
+	data, revision, err := s.configManager.GetRevision(r.Context(), caller, query.Get("owner"), filename, number)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	response := ConfigRevisionResponse{
+		ConfigResponse: ConfigResponse{
+			UserID:   caller.Namespace(query.Get("owner")),
+			Filename: filename,
+			FileType: revision.FileType,
+			Data:     data,
+		},
+		Revision: newRevisionResponse(revision),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// rollbackConfig handles POST /config/rollback
+func (s *Server) rollbackConfig(w http.ResponseWriter, r *http.Request) {
+	var req RollbackRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request", http.StatusBadRequest)
+		return
+	}
+
+	if req.Filename == "" || req.Revision <= 0 {
+		http.Error(w, "Filename and revision are required", http.StatusBadRequest)
+		return
+	}
+
+	revision, err := s.configManager.RollbackConfig(r.Context(), requestCaller(r), req.Owner, req.Filename, req.Revision)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(newRevisionResponse(revision))
+}
+
+// diffConfig handles GET /config/diff
+func (s *Server) diffConfig(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	caller := requestCaller(r)
+	filename := query.Get("filename")
+
+	if filename == "" || query.Get("from") == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	from, err := strconv.Atoi(query.Get("from"))
+	if err != nil {
+		http.Error(w, "Invalid from revision", http.StatusBadRequest)
+		return
+	}
+	to := 0
+	if query.Get("to") != "" {
+		if to, err = strconv.Atoi(query.Get("to")); err != nil {
+			http.Error(w, "Invalid to revision", http.StatusBadRequest)
+			return
+		}
+	}
+
+	diff, err := s.configManager.DiffConfig(r.Context(), caller, query.Get("owner"), filename, from, to)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	response := DiffResponse{
+		UserID:       caller.Namespace(query.Get("owner")),
+		Filename:     filename,
+		FromRevision: diff.From,
+		ToRevision:   diff.To,
+		Unified:      diff.Unified,
+		Structural:   diff.Structural,
+		Changes:      diff.Changes,
 	}
 
 	w.Header().Set("Content-Type", "application/json")
@@ -198,9 +674,32 @@ func (s *Server) addUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := s.userManager.AddUser(r.Context(), req.UserID, req.Email, req.Name, req.Password)
+	role, err := users.ParseRole(req.Role)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	err = s.userManager.AddUser(r.Context(), req.UserID, req.Email, req.Name, req.Password, role)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+}
+
+// addFirstUser handles POST /user without credentials while no user
+// exists. The user becomes an admin regardless of the requested role.
+func (s *Server) addFirstUser(w http.ResponseWriter, r *http.Request) {
+	var req UserRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request", http.StatusBadRequest)
+		return
+	}
+
+	if err := s.userManager.AddFirstUser(r.Context(), req.UserID, req.Email, req.Name, req.Password); err != nil {
+		writeError(w, err)
 		return
 	}
 
@@ -215,9 +714,24 @@ func (s *Server) updateUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := s.userManager.UpdateUser(r.Context(), req.UserID, req.Email, req.Name, req.Password)
+	role, err := users.ParseRole(req.Role)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeError(w, err)
+		return
+	}
+
+	// Users may update themselves except for their role
+	identity := users.IdentityFromContext(r.Context())
+	if err := users.Authorize(identity, users.PermissionManageUsers); err != nil {
+		if identity.ServiceAccount != "" || identity.UserID != req.UserID || role != "" {
+			writeError(w, err)
+			return
+		}
+	}
+
+	err = s.userManager.UpdateUser(r.Context(), req.UserID, req.Email, req.Name, req.Password, role)
+	if err != nil {
+		writeError(w, err)
 		return
 	}
 
@@ -229,11 +743,21 @@ func (s *Server) deleteUser(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	userID := vars["userID"]
 
-	err := s.userManager.DeleteUser(r.Context(), userID)
-	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+	// Files and grants left behind would pass to the next user of that ID
+	if err := s.configManager.CheckNamespaceEmpty(r.Context(), userID); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	if err := s.userManager.DeleteUser(r.Context(), userID); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	if err := s.configManager.RevokeGrants(r.Context(), userID); err != nil {
+		writeError(w, err)
 		return
 	}
 
 	w.WriteHeader(http.StatusOK)
-}
\ No newline at end of file
+}
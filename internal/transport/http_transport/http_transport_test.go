@@ -0,0 +1,49 @@
+package http_transport
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/yash3004/config_server/configurations"
+)
+
+func TestParseIfMatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		headers []string
+		want    []string
+		wantErr bool
+	}{
+		{name: "absent"},
+		{name: "any", headers: []string{"*"}},
+		{name: "one", headers: []string{`"a"`}, want: []string{"a"}},
+		{name: "unquoted", headers: []string{"a"}, want: []string{"a"}},
+		{name: "list", headers: []string{`"a", "b"`}, want: []string{"a", "b"}},
+		{name: "list without spaces", headers: []string{`"a","b",,"c"`}, want: []string{"a", "b", "c"}},
+		{name: "comma in an ETag", headers: []string{`"a,b", "c"`}, want: []string{"a,b", "c"}},
+		{name: "several headers", headers: []string{`"a"`, `"b"`}, want: []string{"a", "b"}},
+		{name: "weak ETags are skipped", headers: []string{`W/"a", "b"`}, want: []string{"b"}},
+		{name: "only weak ETags", headers: []string{`W/"a", W/"b"`}, wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPut, "/config", nil)
+			for _, header := range test.headers {
+				r.Header.Add("If-Match", header)
+			}
+			got, err := parseIfMatch(r)
+			if test.wantErr {
+				if !errors.Is(err, configurations.ErrPreconditionFailed) {
+					t.Errorf("got %v, want %v", err, configurations.ErrPreconditionFailed)
+				}
+				return
+			}
+			if err != nil || !slices.Equal(got, test.want) {
+				t.Errorf("got %q, %v; want %q", got, err, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package http_transport
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/yash3004/config_server/configurations"
+)
+
+type SchemaRequest struct {
+	// Owner is the namespace of the schema, empty for the caller's own
+	Owner   string `json:"owner,omitempty"`
+	Pattern string `json:"pattern"`
+	// Schema is the JSON Schema document
+	Schema json.RawMessage `json:"schema"`
+}
+
+// listSchemas handles GET /config/schemas
+func (s *Server) listSchemas(w http.ResponseWriter, r *http.Request) {
+	schemas, err := s.configManager.ListSchemas(r.Context(), requestCaller(r), r.URL.Query().Get("owner"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if schemas == nil {
+		schemas = []*configurations.Schema{}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(schemas)
+}
+
+// setSchema handles PUT /config/schemas
+func (s *Server) setSchema(w http.ResponseWriter, r *http.Request) {
+	var req SchemaRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request", http.StatusBadRequest)
+		return
+	}
+
+	if req.Pattern == "" || len(req.Schema) == 0 {
+		http.Error(w, "Pattern and schema are required", http.StatusBadRequest)
+		return
+	}
+
+	schema, err := s.configManager.SetSchema(r.Context(), requestCaller(r), req.Owner, req.Pattern, req.Schema)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(schema)
+}
+
+// deleteSchema handles DELETE /config/schemas
+func (s *Server) deleteSchema(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	pattern := query.Get("pattern")
+	if pattern == "" {
+		http.Error(w, "Pattern is required", http.StatusBadRequest)
+		return
+	}
+
+	if err := s.configManager.DeleteSchema(r.Context(), requestCaller(r), query.Get("owner"), pattern); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,209 @@
+package http_transport
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/yash3004/config_server/users"
+)
+
+type ServiceAccountRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	// Role defaults to "viewer" and may not exceed the role of the caller
+	Role string `json:"role,omitempty"`
+}
+
+type ServiceAccountResponse struct {
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Role        string    `json:"role"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type APIKeyRequest struct {
+	Name      string     `json:"name"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+type APIKeyResponse struct {
+	KeyID          string     `json:"key_id"`
+	ServiceAccount string     `json:"service_account"`
+	Name           string     `json:"name"`
+	CreatedAt      time.Time  `json:"created_at"`
+	ExpiresAt      *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt     *time.Time `json:"last_used_at,omitempty"`
+}
+
+type CreateAPIKeyResponse struct {
+	// Key is only returned once; send it as "Authorization: Bearer <key>"
+	Key    string         `json:"key"`
+	APIKey APIKeyResponse `json:"api_key"`
+}
+
+func newServiceAccountResponse(account *users.ServiceAccount) ServiceAccountResponse {
+	return ServiceAccountResponse{
+		Name:        account.Name,
+		Description: account.Description,
+		Role:        string(account.Role),
+		CreatedAt:   account.CreatedAt,
+	}
+}
+
+func newAPIKeyResponse(key *users.APIKey) APIKeyResponse {
+	response := APIKeyResponse{
+		KeyID:          key.KeyID,
+		ServiceAccount: key.ServiceAccount,
+		Name:           key.Name,
+		CreatedAt:      key.CreatedAt,
+	}
+	if !key.ExpiresAt.IsZero() {
+		response.ExpiresAt = &key.ExpiresAt
+	}
+	if !key.LastUsedAt.IsZero() {
+		response.LastUsedAt = &key.LastUsedAt
+	}
+	return response
+}
+
+// createServiceAccount handles POST /service-accounts
+func (s *Server) createServiceAccount(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUser(w, r)
+	if !ok {
+		return
+	}
+
+	var req ServiceAccountRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request", http.StatusBadRequest)
+		return
+	}
+
+	role, err := users.ParseRole(req.Role)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	account, err := s.userManager.CreateServiceAccount(r.Context(), userID, req.Name, req.Description, role)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(newServiceAccountResponse(account))
+}
+
+// listServiceAccounts handles GET /service-accounts
+func (s *Server) listServiceAccounts(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUser(w, r)
+	if !ok {
+		return
+	}
+
+	accounts, err := s.userManager.ListServiceAccounts(r.Context(), userID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	response := make([]ServiceAccountResponse, 0, len(accounts))
+	for _, account := range accounts {
+		response = append(response, newServiceAccountResponse(account))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// deleteServiceAccount handles DELETE /service-accounts/{name}
+func (s *Server) deleteServiceAccount(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUser(w, r)
+	if !ok {
+		return
+	}
+
+	err := s.userManager.DeleteServiceAccount(r.Context(), userID, mux.Vars(r)["name"])
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// createAPIKey handles POST /service-accounts/{name}/keys
+func (s *Server) createAPIKey(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUser(w, r)
+	if !ok {
+		return
+	}
+
+	var req APIKeyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request", http.StatusBadRequest)
+		return
+	}
+
+	var expiresAt time.Time
+	if req.ExpiresAt != nil {
+		expiresAt = *req.ExpiresAt
+	}
+
+	key, apiKey, err := s.userManager.CreateAPIKey(r.Context(), userID, mux.Vars(r)["name"], req.Name, expiresAt)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(CreateAPIKeyResponse{
+		Key:    key,
+		APIKey: newAPIKeyResponse(apiKey),
+	})
+}
+
+// listAPIKeys handles GET /service-accounts/{name}/keys
+func (s *Server) listAPIKeys(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUser(w, r)
+	if !ok {
+		return
+	}
+
+	keys, err := s.userManager.ListAPIKeys(r.Context(), userID, mux.Vars(r)["name"])
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	response := make([]APIKeyResponse, 0, len(keys))
+	for _, key := range keys {
+		response = append(response, newAPIKeyResponse(key))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// revokeAPIKey handles DELETE /service-accounts/{name}/keys/{keyID}
+func (s *Server) revokeAPIKey(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUser(w, r)
+	if !ok {
+		return
+	}
+
+	vars := mux.Vars(r)
+	err := s.userManager.RevokeAPIKey(r.Context(), userID, vars["name"], vars["keyID"])
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
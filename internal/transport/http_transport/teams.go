@@ -0,0 +1,199 @@
+package http_transport
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/yash3004/config_server/configurations"
+	"github.com/yash3004/config_server/users"
+)
+
+type TeamRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type TeamResponse struct {
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Members     []string  `json:"members"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type TeamMemberRequest struct {
+	UserID string `json:"user_id"`
+}
+
+type GrantRequest struct {
+	// Owner is the namespace of the file, empty for the caller's own
+	Owner    string `json:"owner,omitempty"`
+	Filename string `json:"filename"`
+	// Grantee is a user ID or "@<team>"
+	Grantee string `json:"grantee"`
+	// Access is "read", "write" or empty to revoke the grant
+	Access string `json:"access"`
+}
+
+type GrantResponse struct {
+	Grantee string `json:"grantee"`
+	Access  string `json:"access"`
+}
+
+func newTeamResponse(team *users.Team) TeamResponse {
+	return TeamResponse{
+		Name:        team.Name,
+		Description: team.Description,
+		Members:     team.Members,
+		CreatedAt:   team.CreatedAt,
+	}
+}
+
+// createTeam handles POST /teams
+func (s *Server) createTeam(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUser(w, r)
+	if !ok {
+		return
+	}
+
+	var req TeamRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request", http.StatusBadRequest)
+		return
+	}
+
+	team, err := s.userManager.CreateTeam(r.Context(), userID, req.Name, req.Description)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(newTeamResponse(team))
+}
+
+// listTeams handles GET /teams
+func (s *Server) listTeams(w http.ResponseWriter, r *http.Request) {
+	teams, err := s.userManager.ListTeams(r.Context(), users.UserIDFromContext(r.Context()))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	response := make([]TeamResponse, 0, len(teams))
+	for _, team := range teams {
+		response = append(response, newTeamResponse(team))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// deleteTeam handles DELETE /teams/{name}
+func (s *Server) deleteTeam(w http.ResponseWriter, r *http.Request) {
+	identity := users.IdentityFromContext(r.Context())
+	name := mux.Vars(r)["name"]
+
+	// Files and grants left behind would pass to the next team of that name
+	if _, err := s.userManager.AuthorizeTeam(r.Context(), identity, name); err != nil {
+		writeError(w, err)
+		return
+	}
+	if err := s.configManager.CheckNamespaceEmpty(r.Context(), configurations.TeamNamespace(name)); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	if err := s.userManager.DeleteTeam(r.Context(), identity, name); err != nil {
+		writeError(w, err)
+		return
+	}
+	if err := s.configManager.RevokeGrants(r.Context(), configurations.TeamNamespace(name)); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// addTeamMember handles POST /teams/{name}/members
+func (s *Server) addTeamMember(w http.ResponseWriter, r *http.Request) {
+	var req TeamMemberRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request", http.StatusBadRequest)
+		return
+	}
+
+	identity := users.IdentityFromContext(r.Context())
+	err := s.userManager.AddTeamMember(r.Context(), identity, mux.Vars(r)["name"], req.UserID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// removeTeamMember handles DELETE /teams/{name}/members/{userID}
+func (s *Server) removeTeamMember(w http.ResponseWriter, r *http.Request) {
+	identity := users.IdentityFromContext(r.Context())
+	vars := mux.Vars(r)
+
+	err := s.userManager.RemoveTeamMember(r.Context(), identity, vars["name"], vars["userID"])
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// listGrants handles GET /config/grants
+func (s *Server) listGrants(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	filename := query.Get("filename")
+
+	if filename == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	grants, err := s.configManager.ListGrants(r.Context(), requestCaller(r), query.Get("owner"), filename)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	response := make([]GrantResponse, 0, len(grants))
+	for _, grant := range grants {
+		response = append(response, GrantResponse{Grantee: grant.Grantee, Access: string(grant.Access)})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// setGrant handles PUT /config/grants
+func (s *Server) setGrant(w http.ResponseWriter, r *http.Request) {
+	var req GrantRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request", http.StatusBadRequest)
+		return
+	}
+
+	if req.Filename == "" || req.Grantee == "" {
+		http.Error(w, "Filename and grantee are required", http.StatusBadRequest)
+		return
+	}
+
+	grant := configurations.Grant{Grantee: req.Grantee, Access: configurations.Access(req.Access)}
+	err := s.configManager.SetGrant(r.Context(), requestCaller(r), req.Owner, req.Filename, grant)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,208 @@
+package http_transport
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/yash3004/config_server/configurations"
+)
+
+// heartbeatInterval is how often an idle event stream sends a comment to
+// keep proxies from closing it. Bearer credentials are checked again at
+// the same interval so revoked tokens end the stream.
+const heartbeatInterval = 15 * time.Second
+
+type WatchEvent struct {
+	Owner    string                  `json:"owner"`
+	Filename string                  `json:"filename,omitempty"`
+	FileType configurations.FileType `json:"file_type,omitempty"`
+	Data     []byte                  `json:"data,omitempty"`
+	Revision *RevisionResponse       `json:"revision,omitempty"`
+}
+
+// eventNames are the SSE event names of the event types
+var eventNames = map[configurations.EventType]string{
+	configurations.EventUpdated: "updated",
+	configurations.EventDeleted: "deleted",
+	configurations.EventSynced:  "synced",
+}
+
+// eventStream writes server-sent events. Its methods may be called
+// concurrently.
+type eventStream struct {
+	mu      sync.Mutex
+	w       http.ResponseWriter
+	flusher http.Flusher
+	started bool
+}
+
+// start sends the response headers of the stream unless it already started
+func (s *eventStream) start() {
+	if s.started {
+		return
+	}
+	s.started = true
+
+	header := s.w.Header()
+	header.Set("Content-Type", "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	header.Set("X-Accel-Buffering", "no")
+	s.w.WriteHeader(http.StatusOK)
+}
+
+// send writes a single event. An empty id leaves the last event ID of the
+// client unchanged.
+func (s *eventStream) send(name, id string, data interface{}) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.start()
+	if id != "" {
+		if _, err := fmt.Fprintf(s.w, "id: %s\n", id); err != nil {
+			return err
+		}
+	}
+	if _, err := fmt.Fprintf(s.w, "event: %s\ndata: %s\n\n", name, payload); err != nil {
+		return err
+	}
+	s.flusher.Flush()
+	return nil
+}
+
+// heartbeat writes a comment line once the stream has started
+func (s *eventStream) heartbeat() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.started {
+		return nil
+	}
+	if _, err := fmt.Fprint(s.w, ": heartbeat\n\n"); err != nil {
+		return err
+	}
+	s.flusher.Flush()
+	return nil
+}
+
+// watchConfig handles GET /config/watch
+func (s *Server) watchConfig(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "Streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	query := r.URL.Query()
+	opts := configurations.WatchOptions{
+		Filename: query.Get("filename"),
+		Prefix:   query.Get("prefix"),
+	}
+
+	// Reconnecting clients send the revision of the last event they got
+	since := r.Header.Get("Last-Event-ID")
+	if since == "" {
+		since = query.Get("since_revision")
+	}
+	if since != "" {
+		revision, err := strconv.Atoi(since)
+		if err != nil {
+			http.Error(w, "Invalid revision", http.StatusBadRequest)
+			return
+		}
+		opts.SinceRevision = revision
+	}
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	stream := &eventStream{w: w, flusher: flusher}
+
+	var wg sync.WaitGroup
+	var authErr error
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		authErr = s.keepAlive(ctx, r, stream)
+		cancel()
+	}()
+
+	err := s.configManager.Watch(ctx, requestCaller(r), query.Get("owner"), opts, func(event *configurations.Event) error {
+		return stream.send(eventNames[event.Type], eventID(opts, event), newWatchEvent(event))
+	})
+	cancel()
+	wg.Wait()
+
+	if authErr != nil {
+		err = authErr
+	}
+	if err == nil || r.Context().Err() != nil {
+		return
+	}
+	if !stream.started {
+		writeError(w, err)
+		return
+	}
+	// Clients reconnect after errors and resume from their last event ID
+	stream.send("error", "", map[string]string{"error": err.Error()})
+}
+
+// keepAlive sends heartbeats until ctx is done and returns an error once
+// the bearer credentials of the request are no longer valid
+func (s *Server) keepAlive(ctx context.Context, r *http.Request, stream *eventStream) error {
+	ticker := time.NewTicker(heartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		if token, ok := bearerToken(r); ok {
+			if _, err := s.userManager.VerifyBearer(ctx, token); err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return err
+			}
+		}
+		if err := stream.heartbeat(); err != nil {
+			return nil
+		}
+	}
+}
+
+// eventID returns the SSE event ID of an event, which is the revision of
+// updates of a single file and empty otherwise. Revisions are numbered per
+// file, so they cannot resume a prefix watch.
+func eventID(opts configurations.WatchOptions, event *configurations.Event) string {
+	if opts.Filename == "" || event.Revision == nil {
+		return ""
+	}
+	return strconv.Itoa(event.Revision.Number)
+}
+
+func newWatchEvent(event *configurations.Event) WatchEvent {
+	watchEvent := WatchEvent{
+		Owner:    event.Namespace,
+		Filename: event.Filename,
+		Data:     event.Data,
+	}
+	if event.Revision != nil {
+		revision := newRevisionResponse(event.Revision)
+		watchEvent.FileType = event.Revision.FileType
+		watchEvent.Revision = &revision
+	}
+	return watchEvent
+}
@@ -0,0 +1,41 @@
+package users
+
+import "context"
+
+// Identity describes the authenticated caller of a request
+type Identity struct {
+	// UserID is the user whose configuration files the caller works on
+	UserID string
+	// ServiceAccount is the name of the service account the caller
+	// authenticated as with an API key, empty for users
+	ServiceAccount string
+	// Role is the role the caller acts with
+	Role Role
+	// Teams are the teams of the user, whose namespaces the caller may
+	// access as well
+	Teams []string
+}
+
+type contextKey struct{}
+
+// ContextWithIdentity returns a copy of ctx carrying the identity of the
+// authenticated caller
+func ContextWithIdentity(ctx context.Context, identity *Identity) context.Context {
+	return context.WithValue(ctx, contextKey{}, identity)
+}
+
+// IdentityFromContext returns the identity of the authenticated caller
+// stored in ctx, or nil when the request was not authenticated
+func IdentityFromContext(ctx context.Context) *Identity {
+	identity, _ := ctx.Value(contextKey{}).(*Identity)
+	return identity
+}
+
+// UserIDFromContext returns the ID of the authenticated user stored in ctx,
+// or an empty string when the request was not authenticated
+func UserIDFromContext(ctx context.Context) string {
+	if identity := IdentityFromContext(ctx); identity != nil {
+		return identity.UserID
+	}
+	return ""
+}
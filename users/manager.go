@@ -3,80 +3,285 @@ package users
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sync"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"k8s.io/klog/v2"
+)
+
+var (
+	// ErrUserExists is returned when adding a user whose ID is taken
+	ErrUserExists = errors.New("user already exists")
+	// ErrUserNotFound is returned when a user does not exist
+	ErrUserNotFound = errors.New("user not found")
 )
 
 // User represents a user in the system
 type User struct {
-	UserID    string    `bson:"user_id"`
-	Email     string    `bson:"email"`
-	Name      string    `bson:"name"`
-	Password  string    `bson:"password"`
-	CreatedAt time.Time `bson:"created_at"`
-	UpdatedAt time.Time `bson:"updated_at"`
+	UserID string `bson:"user_id"`
+	Email  string `bson:"email"`
+	Name   string `bson:"name"`
+	// Password is the argon2id hash of the user's password
+	Password string `bson:"password"`
+	// Role is empty for users stored before roles were introduced, who get
+	// DefaultRole
+	Role Role `bson:"role,omitempty"`
+	// FirstAdmin marks the user added while no user existed. A unique index
+	// admits a single one, so concurrent first users cannot all become
+	// admins.
+	FirstAdmin bool      `bson:"first_admin,omitempty"`
+	CreatedAt  time.Time `bson:"created_at"`
+	UpdatedAt  time.Time `bson:"updated_at"`
 }
 
 // UserManager handles user operations
 type UserManager struct {
 	db         *mongo.Database
 	collection *mongo.Collection
+	tokens     *mongo.Collection
+	tokenTTL   time.Duration
+
+	serviceAccounts *mongo.Collection
+	apiKeys         *mongo.Collection
+	teams           *mongo.Collection
+
+	indexMu sync.Mutex
+	indexed bool
 }
 
-// NewUserManager creates a new user manager
-func NewUserManager(db *mongo.Database) *UserManager {
+// NewUserManager creates a new user manager issuing bearer tokens valid for
+// tokenTTL, or DefaultTokenTTL when tokenTTL is zero
+func NewUserManager(db *mongo.Database, tokenTTL time.Duration) *UserManager {
+	if tokenTTL <= 0 {
+		tokenTTL = DefaultTokenTTL
+	}
 	return &UserManager{
 		db:         db,
 		collection: db.Collection("users"),
+		tokens:     db.Collection("tokens"),
+		tokenTTL:   tokenTTL,
+
+		serviceAccounts: db.Collection("service_accounts"),
+		apiKeys:         db.Collection("api_keys"),
+		teams:           db.Collection("teams"),
+	}
+}
+
+// ensureIndexes creates the indexes of the user, token, service account,
+// API key and team collections. Expired tokens are removed by a TTL index.
+func (um *UserManager) ensureIndexes(ctx context.Context) error {
+	um.indexMu.Lock()
+	defer um.indexMu.Unlock()
+
+	if um.indexed {
+		return nil
+	}
+
+	_, err := um.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "user_id", Value: 1}},
+			Options: options.Index().SetName("user_id").SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "first_admin", Value: 1}},
+			Options: options.Index().SetName("first_admin").SetUnique(true).
+				SetPartialFilterExpression(bson.M{"first_admin": true}),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = um.tokens.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "hash", Value: 1}},
+			Options: options.Index().SetName("token_hash").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "expires_at", Value: 1}},
+			Options: options.Index().SetName("token_expiry").SetExpireAfterSeconds(0),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = um.serviceAccounts.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "owner_id", Value: 1}, {Key: "name", Value: 1}},
+		Options: options.Index().SetName("service_account_name").SetUnique(true),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = um.apiKeys.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "hash", Value: 1}},
+			Options: options.Index().SetName("api_key_hash").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "owner_id", Value: 1}, {Key: "service_account", Value: 1}},
+			Options: options.Index().SetName("api_key_account"),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = um.teams.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "name", Value: 1}},
+			Options: options.Index().SetName("team_name").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "members", Value: 1}},
+			Options: options.Index().SetName("team_members"),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	um.indexed = true
+	return nil
+}
+
+// AddUser adds a new user with role, or DefaultRole when role is empty
+func (um *UserManager) AddUser(ctx context.Context, userID, email, name, password string, role Role) error {
+	if role == "" {
+		role = DefaultRole
+	}
+	if !role.Valid() {
+		return fmt.Errorf("%w: %q", ErrInvalidRole, role)
+	}
+
+	err := um.insertUser(ctx, userID, email, name, password, role, false)
+	if mongo.IsDuplicateKeyError(err) {
+		return ErrUserExists
 	}
+	return err
 }
 
-// AddUser adds a new user
-func (um *UserManager) AddUser(ctx context.Context, userID, email, name, password string) error {
-	// Check if user already exists
+// AddFirstUser adds the first user, who becomes an admin. It fails with
+// ErrPermissionDenied once a user exists, including when a concurrent call
+// added the first user.
+func (um *UserManager) AddFirstUser(ctx context.Context, userID, email, name, password string) error {
+	hasUsers, err := um.HasUsers(ctx)
+	if err != nil {
+		return err
+	}
+	if hasUsers {
+		return fmt.Errorf("%w: the first user was already added", ErrPermissionDenied)
+	}
+
+	err = um.insertUser(ctx, userID, email, name, password, RoleAdmin, true)
+	if mongo.IsDuplicateKeyError(err) {
+		return fmt.Errorf("%w: the first user was already added", ErrPermissionDenied)
+	}
+	return err
+}
+
+// insertUser validates and stores a new user. Existing user IDs and a
+// second first admin fail with a duplicate key error.
+func (um *UserManager) insertUser(ctx context.Context, userID, email, name, password string, role Role, firstAdmin bool) error {
+	if err := validateUserID(userID); err != nil {
+		return err
+	}
+	if err := um.ensureIndexes(ctx); err != nil {
+		return err
+	}
+
+	// Check if user already exists before spending the time of hashing
 	count, err := um.collection.CountDocuments(ctx, bson.M{"user_id": userID})
 	if err != nil {
 		return err
 	}
 	if count > 0 {
-		return errors.New("user already exists")
+		return ErrUserExists
+	}
+
+	hash, err := hashPassword(password)
+	if err != nil {
+		return err
 	}
 
 	// Create new user
 	user := User{
-		UserID:    userID,
-		Email:     email,
-		Name:      name,
-		Password:  password, // In a real application, this should be hashed
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
+		UserID:     userID,
+		Email:      email,
+		Name:       name,
+		Password:   hash,
+		Role:       role,
+		FirstAdmin: firstAdmin,
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
 	}
 
 	_, err = um.collection.InsertOne(ctx, user)
 	return err
 }
 
-// UpdateUser updates an existing user
-func (um *UserManager) UpdateUser(ctx context.Context, userID, email, name, password string) error {
-	update := bson.M{
-		"$set": bson.M{
-			"email":      email,
-			"name":       name,
-			"password":   password, // In a real application, this should be hashed
-			"updated_at": time.Now(),
-		},
+// UpdateUser updates an existing user. An empty password or role keeps the
+// current one.
+func (um *UserManager) UpdateUser(ctx context.Context, userID, email, name, password string, role Role) error {
+	set := bson.M{
+		"email":      email,
+		"name":       name,
+		"updated_at": time.Now(),
+	}
+	if password != "" {
+		hash, err := hashPassword(password)
+		if err != nil {
+			return err
+		}
+		set["password"] = hash
+	}
+	if role != "" {
+		if !role.Valid() {
+			return fmt.Errorf("%w: %q", ErrInvalidRole, role)
+		}
+		set["role"] = role
 	}
 
-	result, err := um.collection.UpdateOne(ctx, bson.M{"user_id": userID}, update)
+	result, err := um.collection.UpdateOne(ctx, bson.M{"user_id": userID}, bson.M{"$set": set})
 	if err != nil {
 		return err
 	}
 	if result.MatchedCount == 0 {
-		return errors.New("user not found")
+		return ErrUserNotFound
 	}
-	return nil
+
+	if password == "" {
+		return nil
+	}
+	// Tokens issued for the old password must not outlive it
+	return um.revokeUserTokens(ctx, userID)
+}
+
+// HasUsers reports whether any user exists. Until one does, the first user
+// can be added without authenticating.
+func (um *UserManager) HasUsers(ctx context.Context) (bool, error) {
+	count, err := um.collection.CountDocuments(ctx, bson.M{}, options.Count().SetLimit(1))
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// PromoteAdmins gives the admin role to existing users, which lets
+// deployments that predate roles appoint their administrators
+func (um *UserManager) PromoteAdmins(ctx context.Context, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	filter := bson.M{"user_id": bson.M{"$in": userIDs}}
+	update := bson.M{"$set": bson.M{"role": RoleAdmin, "updated_at": time.Now()}}
+	_, err := um.collection.UpdateMany(ctx, filter, update)
+	return err
 }
 
 // DeleteUser deletes a user
@@ -86,26 +291,97 @@ func (um *UserManager) DeleteUser(ctx context.Context, userID string) error {
 		return err
 	}
 	if result.DeletedCount == 0 {
-		return errors.New("user not found")
+		return ErrUserNotFound
 	}
-	return nil
+
+	if err := um.deleteServiceAccounts(ctx, userID); err != nil {
+		return err
+	}
+	if err := um.leaveTeams(ctx, userID); err != nil {
+		return err
+	}
+	return um.revokeUserTokens(ctx, userID)
 }
 
-// AuthenticateUser verifies user credentials
+// AuthenticateUser verifies user credentials. Passwords still stored in
+// plaintext or hashed with outdated parameters are rehashed on success.
 func (um *UserManager) AuthenticateUser(ctx context.Context, userID, password string) (bool, error) {
 	var user User
 	err := um.collection.FindOne(ctx, bson.M{"user_id": userID}).Decode(&user)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
-			return false, errors.New("user not found")
+			// Spend the time of a password check so response times do not
+			// reveal which users exist
+			hashPassword(password)
+			return false, ErrUserNotFound
 		}
 		return false, err
 	}
 
-	// In a real application, you would compare hashed passwords
-	if user.Password != password {
+	ok, needsRehash, err := verifyPassword(user.Password, password)
+	if err != nil {
+		return false, err
+	}
+	if !ok {
 		return false, errors.New("invalid password")
 	}
 
+	if needsRehash {
+		if err := um.rehashPassword(ctx, &user, password); err != nil {
+			klog.FromContext(ctx).Error(err, "cannot rehash password", "user", userID)
+		}
+	}
+
 	return true, nil
-}
\ No newline at end of file
+}
+
+// rehashPassword replaces the stored password of a user with a fresh hash,
+// unless the password was changed since it was read
+func (um *UserManager) rehashPassword(ctx context.Context, user *User, password string) error {
+	hash, err := hashPassword(password)
+	if err != nil {
+		return err
+	}
+
+	_, err = um.collection.UpdateOne(ctx,
+		bson.M{"user_id": user.UserID, "password": user.Password},
+		bson.M{"$set": bson.M{"password": hash}},
+	)
+	return err
+}
+
+// AuthenticatePassword verifies user credentials and returns the identity
+// of the user
+func (um *UserManager) AuthenticatePassword(ctx context.Context, userID, password string) (*Identity, error) {
+	authenticated, err := um.AuthenticateUser(ctx, userID, password)
+	if err != nil {
+		return nil, err
+	}
+	if !authenticated {
+		return nil, errors.New("invalid password")
+	}
+	return um.Identify(ctx, userID)
+}
+
+// Identify returns the identity of an existing user, including the teams
+// it belongs to
+func (um *UserManager) Identify(ctx context.Context, userID string) (*Identity, error) {
+	var user User
+	err := um.collection.FindOne(ctx, bson.M{"user_id": userID}).Decode(&user)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	role := user.Role
+	if role == "" {
+		role = DefaultRole
+	}
+	teams, err := um.teamNames(ctx, user.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &Identity{UserID: user.UserID, Role: role, Teams: teams}, nil
+}
@@ -0,0 +1,134 @@
+package users
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// hashWith hashes a password like hashPassword with the given parameters
+func hashWith(password string, time, memory uint32, threads uint8, keyLen uint32) string {
+	salt := []byte("0123456789abcdef")
+	key := argon2.IDKey([]byte(password), salt, time, memory, threads, keyLen)
+	return fmt.Sprintf("%sv=%d$m=%d,t=%d,p=%d$%s$%s",
+		argonPrefix, argon2.Version, memory, time, threads,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	)
+}
+
+func TestHashPassword(t *testing.T) {
+	hash, err := hashPassword("secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(hash, argonPrefix) || strings.Contains(hash, "secret") {
+		t.Errorf("hash %q is not an argon2id PHC string", hash)
+	}
+	if again, _ := hashPassword("secret"); again == hash {
+		t.Error("hashing a password twice gave the same salt")
+	}
+
+	tests := []struct {
+		password string
+		ok       bool
+	}{
+		{"secret", true},
+		{"Secret", false},
+		{"secret ", false},
+		{"", false},
+	}
+	for _, test := range tests {
+		ok, needsRehash, err := verifyPassword(hash, test.password)
+		if err != nil || ok != test.ok || needsRehash {
+			t.Errorf("verifyPassword(%q) = %v, %v, %v; want %v, false, nil", test.password, ok, needsRehash, err, test.ok)
+		}
+	}
+}
+
+func TestVerifyPasswordRehash(t *testing.T) {
+	tests := []struct {
+		name        string
+		stored      string
+		password    string
+		ok          bool
+		needsRehash bool
+	}{
+		{name: "current parameters", stored: hashWith("secret", argonTime, argonMemory, argonThreads, argonKeyLen), password: "secret", ok: true},
+		{name: "legacy plaintext", stored: "secret", password: "secret", ok: true, needsRehash: true},
+		{name: "wrong legacy plaintext", stored: "secret", password: "other"},
+		{name: "empty legacy plaintext", stored: "", password: "x"},
+		{name: "fewer passes", stored: hashWith("secret", 1, argonMemory, argonThreads, argonKeyLen), password: "secret", ok: true, needsRehash: true},
+		{name: "less memory", stored: hashWith("secret", argonTime, 32*1024, argonThreads, argonKeyLen), password: "secret", ok: true, needsRehash: true},
+		{name: "more threads", stored: hashWith("secret", argonTime, argonMemory, 4, argonKeyLen), password: "secret", ok: true, needsRehash: true},
+		{name: "shorter key", stored: hashWith("secret", argonTime, argonMemory, argonThreads, 16), password: "secret", ok: true, needsRehash: true},
+		// A wrong password is never rehashed, whatever the parameters
+		{name: "wrong password with old parameters", stored: hashWith("secret", 1, argonMemory, argonThreads, argonKeyLen), password: "other"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ok, needsRehash, err := verifyPassword(test.stored, test.password)
+			if err != nil || ok != test.ok || needsRehash != test.needsRehash {
+				t.Errorf("got %v, %v, %v; want %v, %v, nil", ok, needsRehash, err, test.ok, test.needsRehash)
+			}
+		})
+	}
+}
+
+func TestLegacyPasswordUpgrade(t *testing.T) {
+	// The login of a user whose password is stored in plaintext replaces it
+	// with a hash, see UserManager.AuthenticateUser
+	ok, needsRehash, err := verifyPassword("secret", "secret")
+	if err != nil || !ok || !needsRehash {
+		t.Fatalf("plaintext: got %v, %v, %v; want a match that needs a rehash", ok, needsRehash, err)
+	}
+	upgraded, err := hashPassword("secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ok, needsRehash, err = verifyPassword(upgraded, "secret")
+	if err != nil || !ok || needsRehash {
+		t.Errorf("upgraded: got %v, %v, %v; want a match that needs no rehash", ok, needsRehash, err)
+	}
+	// The hash does not match its own text, as a plaintext record would
+	if ok, _, _ := verifyPassword(upgraded, upgraded); ok {
+		t.Error("the upgraded hash is accepted as the password")
+	}
+}
+
+func TestVerifyPasswordMalformedHash(t *testing.T) {
+	valid := hashWith("secret", argonTime, argonMemory, argonThreads, argonKeyLen)
+	parts := strings.Split(valid, "$")
+	salt, key := parts[4], parts[5]
+	tests := []struct {
+		name   string
+		stored string
+	}{
+		{name: "missing key", stored: strings.Join(parts[:5], "$")},
+		{name: "extra field", stored: valid + "$x"},
+		{name: "other version", stored: fmt.Sprintf("%sv=16$m=65536,t=3,p=2$%s$%s", argonPrefix, salt, key)},
+		{name: "missing version", stored: fmt.Sprintf("%s$m=65536,t=3,p=2$%s$%s", argonPrefix, salt, key)},
+		{name: "unreadable parameters", stored: fmt.Sprintf("%sv=19$m=x,t=3,p=2$%s$%s", argonPrefix, salt, key)},
+		{name: "parameters out of order", stored: fmt.Sprintf("%sv=19$t=3,m=65536,p=2$%s$%s", argonPrefix, salt, key)},
+		{name: "no passes", stored: fmt.Sprintf("%sv=19$m=65536,t=0,p=2$%s$%s", argonPrefix, salt, key)},
+		{name: "no threads", stored: fmt.Sprintf("%sv=19$m=65536,t=3,p=0$%s$%s", argonPrefix, salt, key)},
+		{name: "too many threads", stored: fmt.Sprintf("%sv=19$m=65536,t=3,p=256$%s$%s", argonPrefix, salt, key)},
+		{name: "negative memory", stored: fmt.Sprintf("%sv=19$m=-1,t=3,p=2$%s$%s", argonPrefix, salt, key)},
+		{name: "too much memory", stored: fmt.Sprintf("%sv=19$m=4294967295,t=3,p=2$%s$%s", argonPrefix, salt, key)},
+		{name: "salt not base64", stored: fmt.Sprintf("%sv=19$m=65536,t=3,p=2$%s$%s", argonPrefix, "!!!", key)},
+		{name: "key not base64", stored: fmt.Sprintf("%sv=19$m=65536,t=3,p=2$%s$%s", argonPrefix, salt, "!!!")},
+		{name: "empty key", stored: fmt.Sprintf("%sv=19$m=65536,t=3,p=2$%s$", argonPrefix, salt)},
+		{name: "prefix only", stored: argonPrefix},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ok, needsRehash, err := verifyPassword(test.stored, "secret")
+			if err != errInvalidHash || ok || needsRehash {
+				t.Errorf("got %v, %v, %v; want false, false, %v", ok, needsRehash, err, errInvalidHash)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package users
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Role determines what a user or service account is allowed to do
+type Role string
+
+const (
+	// RoleViewer may read configuration files
+	RoleViewer Role = "viewer"
+	// RoleEditor may read and write configuration files and manage its
+	// service accounts and teams
+	RoleEditor Role = "editor"
+	// RoleAdmin may additionally manage users
+	RoleAdmin Role = "admin"
+)
+
+// DefaultRole is the role of users created without one and of users stored
+// before roles were introduced
+const DefaultRole = RoleEditor
+
+// Permission is an action guarded by the role of the caller
+type Permission int
+
+const (
+	PermissionReadConfigs Permission = iota
+	PermissionWriteConfigs
+	PermissionManageServiceAccounts
+	PermissionManageTeams
+	PermissionManageUsers
+)
+
+var (
+	// ErrPermissionDenied is returned when the role of a caller does not
+	// grant an action
+	ErrPermissionDenied = errors.New("permission denied")
+	// ErrInvalidRole is returned for unknown role names
+	ErrInvalidRole = errors.New("invalid role")
+)
+
+// rolePermissions lists the permissions granted by each role
+var rolePermissions = map[Role][]Permission{
+	RoleViewer: {PermissionReadConfigs},
+	RoleEditor: {PermissionReadConfigs, PermissionWriteConfigs, PermissionManageServiceAccounts, PermissionManageTeams},
+	RoleAdmin: {PermissionReadConfigs, PermissionWriteConfigs, PermissionManageServiceAccounts, PermissionManageTeams,
+		PermissionManageUsers},
+}
+
+// roleRanks orders roles so that a role includes the permissions of every
+// lower ranked role
+var roleRanks = map[Role]int{
+	RoleViewer: 1,
+	RoleEditor: 2,
+	RoleAdmin:  3,
+}
+
+// ParseRole converts a role name into a Role. The empty name yields the
+// empty role, which callers treat as "unchanged" or "default".
+func ParseRole(name string) (Role, error) {
+	role := Role(name)
+	if name != "" && !role.Valid() {
+		return "", fmt.Errorf("%w: %q", ErrInvalidRole, name)
+	}
+	return role, nil
+}
+
+// Valid reports whether r is a known role
+func (r Role) Valid() bool {
+	_, ok := roleRanks[r]
+	return ok
+}
+
+// Can reports whether r grants permission p
+func (r Role) Can(p Permission) bool {
+	for _, granted := range rolePermissions[r] {
+		if granted == p {
+			return true
+		}
+	}
+	return false
+}
+
+// Includes reports whether r grants at least the permissions of other
+func (r Role) Includes(other Role) bool {
+	return roleRanks[r] >= roleRanks[other]
+}
+
+// minRole returns the less privileged of two roles
+func minRole(a, b Role) Role {
+	if a.Includes(b) {
+		return b
+	}
+	return a
+}
+
+// Authorize returns ErrPermissionDenied unless the role of identity grants
+// permission p
+func Authorize(identity *Identity, p Permission) error {
+	if identity == nil || !identity.Role.Can(p) {
+		return ErrPermissionDenied
+	}
+	return nil
+}
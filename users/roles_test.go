@@ -0,0 +1,112 @@
+package users
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRoleCan(t *testing.T) {
+	permissions := []Permission{
+		PermissionReadConfigs,
+		PermissionWriteConfigs,
+		PermissionManageServiceAccounts,
+		PermissionManageTeams,
+		PermissionManageUsers,
+	}
+	tests := []struct {
+		role Role
+		// granted lists the permissions of role in the order above
+		granted []bool
+	}{
+		{RoleViewer, []bool{true, false, false, false, false}},
+		{RoleEditor, []bool{true, true, true, true, false}},
+		{RoleAdmin, []bool{true, true, true, true, true}},
+		{Role(""), []bool{false, false, false, false, false}},
+		{Role("root"), []bool{false, false, false, false, false}},
+	}
+	for _, test := range tests {
+		for i, permission := range permissions {
+			if got := test.role.Can(permission); got != test.granted[i] {
+				t.Errorf("%q.Can(%d) = %v, want %v", test.role, permission, got, test.granted[i])
+			}
+		}
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	tests := []struct {
+		name       string
+		identity   *Identity
+		permission Permission
+		allowed    bool
+	}{
+		{name: "viewer reads", identity: &Identity{UserID: "v", Role: RoleViewer}, permission: PermissionReadConfigs, allowed: true},
+		{name: "viewer writes", identity: &Identity{UserID: "v", Role: RoleViewer}, permission: PermissionWriteConfigs},
+		{name: "viewer manages service accounts", identity: &Identity{UserID: "v", Role: RoleViewer}, permission: PermissionManageServiceAccounts},
+		{name: "editor writes", identity: &Identity{UserID: "e", Role: RoleEditor}, permission: PermissionWriteConfigs, allowed: true},
+		{name: "editor manages users", identity: &Identity{UserID: "e", Role: RoleEditor}, permission: PermissionManageUsers},
+		{name: "admin manages users", identity: &Identity{UserID: "a", Role: RoleAdmin}, permission: PermissionManageUsers, allowed: true},
+		{name: "viewer service account of an admin", identity: &Identity{UserID: "a", ServiceAccount: "ci", Role: RoleViewer}, permission: PermissionWriteConfigs},
+		{name: "no role", identity: &Identity{UserID: "x"}, permission: PermissionReadConfigs},
+		{name: "no identity", permission: PermissionReadConfigs},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := Authorize(test.identity, test.permission)
+			if test.allowed {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, ErrPermissionDenied) {
+				t.Errorf("got %v, want %v", err, ErrPermissionDenied)
+			}
+		})
+	}
+}
+
+func TestRoleOrder(t *testing.T) {
+	tests := []struct {
+		a, b     Role
+		includes bool
+		min      Role
+	}{
+		{RoleAdmin, RoleEditor, true, RoleEditor},
+		{RoleEditor, RoleAdmin, false, RoleEditor},
+		{RoleEditor, RoleViewer, true, RoleViewer},
+		{RoleViewer, RoleViewer, true, RoleViewer},
+		{RoleViewer, RoleAdmin, false, RoleViewer},
+	}
+	for _, test := range tests {
+		if got := test.a.Includes(test.b); got != test.includes {
+			t.Errorf("%q.Includes(%q) = %v, want %v", test.a, test.b, got, test.includes)
+		}
+		if got := minRole(test.a, test.b); got != test.min {
+			t.Errorf("minRole(%q, %q) = %q, want %q", test.a, test.b, got, test.min)
+		}
+	}
+}
+
+func TestParseRole(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    Role
+		wantErr bool
+	}{
+		{"", "", false},
+		{"viewer", RoleViewer, false},
+		{"admin", RoleAdmin, false},
+		{"Admin", "", true},
+		{"root", "", true},
+	}
+	for _, test := range tests {
+		got, err := ParseRole(test.name)
+		if got != test.want || (err != nil) != test.wantErr {
+			t.Errorf("ParseRole(%q) = %q, %v; want %q, error %v", test.name, got, err, test.want, test.wantErr)
+		}
+		if err != nil && !errors.Is(err, ErrInvalidRole) {
+			t.Errorf("ParseRole(%q) error %v does not wrap ErrInvalidRole", test.name, err)
+		}
+	}
+}
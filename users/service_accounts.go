@@ -0,0 +1,310 @@
+package users
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// apiKeyPrefix marks API keys so they can be told apart from login tokens
+const apiKeyPrefix = "csk_"
+
+// lastUsedResolution limits how often the last-used time of an API key is
+// written back to the database
+const lastUsedResolution = time.Minute
+
+var (
+	// ErrServiceAccountExists is returned when creating a service account
+	// whose name is already taken by its owner
+	ErrServiceAccountExists = errors.New("service account already exists")
+	// ErrServiceAccountNotFound is returned when a service account does not
+	// exist
+	ErrServiceAccountNotFound = errors.New("service account not found")
+	// ErrAPIKeyNotFound is returned when an API key does not exist
+	ErrAPIKeyNotFound = errors.New("API key not found")
+	// ErrInvalidName is returned when a service account or API key name is
+	// not valid
+	ErrInvalidName = errors.New("invalid name")
+)
+
+// ServiceAccount is a non-human principal owned by a user. Clients that
+// authenticate with one of its API keys act on the configuration files of
+// the owner with the role of the service account, limited to the role of
+// the owner.
+type ServiceAccount struct {
+	OwnerID     string    `bson:"owner_id"`
+	Name        string    `bson:"name"`
+	Description string    `bson:"description"`
+	Role        Role      `bson:"role,omitempty"`
+	CreatedAt   time.Time `bson:"created_at"`
+}
+
+// APIKey is the metadata of an API key of a service account. Only the
+// SHA-256 hash of the key itself is stored.
+type APIKey struct {
+	KeyID          string    `bson:"key_id"`
+	OwnerID        string    `bson:"owner_id"`
+	ServiceAccount string    `bson:"service_account"`
+	Name           string    `bson:"name"`
+	Hash           string    `bson:"hash"`
+	CreatedAt      time.Time `bson:"created_at"`
+	// ExpiresAt is zero for keys that never expire
+	ExpiresAt  time.Time `bson:"expires_at,omitempty"`
+	LastUsedAt time.Time `bson:"last_used_at,omitempty"`
+}
+
+// CreateServiceAccount adds a service account owned by ownerID. The role
+// defaults to RoleViewer and may not exceed the role of the owner.
+func (um *UserManager) CreateServiceAccount(ctx context.Context, ownerID, name, description string, role Role) (*ServiceAccount, error) {
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+	if role == "" {
+		role = RoleViewer
+	}
+	if !role.Valid() {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidRole, role)
+	}
+
+	owner, err := um.Identify(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	if !owner.Role.Includes(role) {
+		return nil, fmt.Errorf("%w: cannot grant a role above your own", ErrPermissionDenied)
+	}
+
+	if err := um.ensureIndexes(ctx); err != nil {
+		return nil, err
+	}
+
+	account := &ServiceAccount{
+		OwnerID:     ownerID,
+		Name:        name,
+		Description: description,
+		Role:        role,
+		CreatedAt:   time.Now(),
+	}
+	if _, err := um.serviceAccounts.InsertOne(ctx, account); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, ErrServiceAccountExists
+		}
+		return nil, err
+	}
+	return account, nil
+}
+
+// ListServiceAccounts returns the service accounts owned by ownerID ordered
+// by name
+func (um *UserManager) ListServiceAccounts(ctx context.Context, ownerID string) ([]*ServiceAccount, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "name", Value: 1}})
+	cursor, err := um.serviceAccounts.Find(ctx, bson.M{"owner_id": ownerID}, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var accounts []*ServiceAccount
+	if err := cursor.All(ctx, &accounts); err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
+// DeleteServiceAccount removes a service account and revokes its API keys
+func (um *UserManager) DeleteServiceAccount(ctx context.Context, ownerID, name string) error {
+	result, err := um.serviceAccounts.DeleteOne(ctx, bson.M{"owner_id": ownerID, "name": name})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return ErrServiceAccountNotFound
+	}
+
+	_, err = um.apiKeys.DeleteMany(ctx, bson.M{"owner_id": ownerID, "service_account": name})
+	return err
+}
+
+// CreateAPIKey issues a new API key for a service account. The key is only
+// returned here; afterwards it can be listed and revoked by its key ID. A
+// zero expiresAt creates a key that does not expire.
+func (um *UserManager) CreateAPIKey(ctx context.Context, ownerID, account, name string, expiresAt time.Time) (string, *APIKey, error) {
+	if err := validateName(name); err != nil {
+		return "", nil, err
+	}
+	if err := um.ensureIndexes(ctx); err != nil {
+		return "", nil, err
+	}
+
+	count, err := um.serviceAccounts.CountDocuments(ctx, bson.M{"owner_id": ownerID, "name": account})
+	if err != nil {
+		return "", nil, err
+	}
+	if count == 0 {
+		return "", nil, ErrServiceAccountNotFound
+	}
+
+	keyID := make([]byte, 8)
+	secret := make([]byte, tokenBytes)
+	if _, err := rand.Read(keyID); err != nil {
+		return "", nil, err
+	}
+	if _, err := rand.Read(secret); err != nil {
+		return "", nil, err
+	}
+	key := apiKeyPrefix + base64.RawURLEncoding.EncodeToString(secret)
+
+	record := &APIKey{
+		KeyID:          hex.EncodeToString(keyID),
+		OwnerID:        ownerID,
+		ServiceAccount: account,
+		Name:           name,
+		Hash:           hashToken(key),
+		CreatedAt:      time.Now(),
+		ExpiresAt:      expiresAt,
+	}
+	if _, err := um.apiKeys.InsertOne(ctx, record); err != nil {
+		return "", nil, err
+	}
+	return key, record, nil
+}
+
+// ListAPIKeys returns the API keys of a service account, oldest first
+func (um *UserManager) ListAPIKeys(ctx context.Context, ownerID, account string) ([]*APIKey, error) {
+	count, err := um.serviceAccounts.CountDocuments(ctx, bson.M{"owner_id": ownerID, "name": account})
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		return nil, ErrServiceAccountNotFound
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}})
+	cursor, err := um.apiKeys.Find(ctx, bson.M{"owner_id": ownerID, "service_account": account}, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []*APIKey
+	if err := cursor.All(ctx, &keys); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// RevokeAPIKey deletes an API key of a service account
+func (um *UserManager) RevokeAPIKey(ctx context.Context, ownerID, account, keyID string) error {
+	filter := bson.M{"owner_id": ownerID, "service_account": account, "key_id": keyID}
+	result, err := um.apiKeys.DeleteOne(ctx, filter)
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return ErrAPIKeyNotFound
+	}
+	return nil
+}
+
+// VerifyAPIKey returns the identity of the service account an API key
+// belongs to and records that the key was used
+func (um *UserManager) VerifyAPIKey(ctx context.Context, key string) (*Identity, error) {
+	var record APIKey
+	err := um.apiKeys.FindOne(ctx, bson.M{"hash": hashToken(key)}).Decode(&record)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, ErrInvalidToken
+		}
+		return nil, err
+	}
+
+	now := time.Now()
+	if !record.ExpiresAt.IsZero() && !now.Before(record.ExpiresAt) {
+		return nil, ErrInvalidToken
+	}
+
+	if now.Sub(record.LastUsedAt) >= lastUsedResolution {
+		filter := bson.M{"hash": record.Hash}
+		update := bson.M{"$set": bson.M{"last_used_at": now}}
+		if _, err := um.apiKeys.UpdateOne(ctx, filter, update); err != nil {
+			return nil, err
+		}
+	}
+
+	return um.serviceAccountIdentity(ctx, record.OwnerID, record.ServiceAccount)
+}
+
+// serviceAccountIdentity returns the identity of a service account, whose
+// role is capped by the current role of its owner
+func (um *UserManager) serviceAccountIdentity(ctx context.Context, ownerID, name string) (*Identity, error) {
+	var account ServiceAccount
+	err := um.serviceAccounts.FindOne(ctx, bson.M{"owner_id": ownerID, "name": name}).Decode(&account)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, ErrInvalidToken
+		}
+		return nil, err
+	}
+
+	owner, err := um.Identify(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	role := account.Role
+	if role == "" {
+		role = RoleViewer
+	}
+	return &Identity{
+		UserID:         ownerID,
+		ServiceAccount: name,
+		Role:           minRole(role, owner.Role),
+		Teams:          owner.Teams,
+	}, nil
+}
+
+// VerifyBearer returns the identity of the caller presenting a bearer
+// credential, which is either an API key or a login token
+func (um *UserManager) VerifyBearer(ctx context.Context, credential string) (*Identity, error) {
+	if strings.HasPrefix(credential, apiKeyPrefix) {
+		return um.VerifyAPIKey(ctx, credential)
+	}
+
+	userID, err := um.VerifyToken(ctx, credential)
+	if err != nil {
+		return nil, err
+	}
+	return um.Identify(ctx, userID)
+}
+
+// deleteServiceAccounts removes every service account and API key owned by
+// a user
+func (um *UserManager) deleteServiceAccounts(ctx context.Context, ownerID string) error {
+	if _, err := um.apiKeys.DeleteMany(ctx, bson.M{"owner_id": ownerID}); err != nil {
+		return err
+	}
+	_, err := um.serviceAccounts.DeleteMany(ctx, bson.M{"owner_id": ownerID})
+	return err
+}
+
+// validateName checks the name of a service account or API key
+func validateName(name string) error {
+	if name == "" || len(name) > 64 {
+		return fmt.Errorf("%w: names must be 1 to 64 characters long", ErrInvalidName)
+	}
+	for _, c := range name {
+		switch {
+		case c >= 'a' && c <= 'z', c >= '0' && c <= '9', c == '-', c == '_':
+		default:
+			return fmt.Errorf("%w: names may only contain lowercase letters, digits, '-' and '_'", ErrInvalidName)
+		}
+	}
+	return nil
+}
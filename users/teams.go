@@ -0,0 +1,187 @@
+package users
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/yash3004/config_server/configurations"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// teamPrefix starts the namespaces of teams, see configurations.TeamNamespace.
+// User IDs may not start with it.
+const teamPrefix = "@"
+
+var (
+	// ErrTeamExists is returned when creating a team whose name is taken
+	ErrTeamExists = errors.New("team already exists")
+	// ErrTeamNotFound is returned when a team does not exist
+	ErrTeamNotFound = errors.New("team not found")
+)
+
+// Team is a group of users sharing a configuration namespace. Every member
+// can read and write the configuration files of the team and manage its
+// members.
+type Team struct {
+	Name        string    `bson:"name"`
+	Description string    `bson:"description"`
+	Members     []string  `bson:"members"`
+	CreatedAt   time.Time `bson:"created_at"`
+}
+
+// HasMember reports whether userID belongs to the team
+func (t *Team) HasMember(userID string) bool {
+	for _, member := range t.Members {
+		if member == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// CreateTeam adds a team whose first member is its creator
+func (um *UserManager) CreateTeam(ctx context.Context, creatorID, name, description string) (*Team, error) {
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+	if err := um.ensureIndexes(ctx); err != nil {
+		return nil, err
+	}
+
+	team := &Team{
+		Name:        name,
+		Description: description,
+		Members:     []string{creatorID},
+		CreatedAt:   time.Now(),
+	}
+	if _, err := um.teams.InsertOne(ctx, team); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, ErrTeamExists
+		}
+		return nil, err
+	}
+	return team, nil
+}
+
+// ListTeams returns the teams userID belongs to ordered by name
+func (um *UserManager) ListTeams(ctx context.Context, userID string) ([]*Team, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "name", Value: 1}})
+	cursor, err := um.teams.Find(ctx, bson.M{"members": userID}, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var teams []*Team
+	if err := cursor.All(ctx, &teams); err != nil {
+		return nil, err
+	}
+	return teams, nil
+}
+
+// DeleteTeam removes a team. Only its members and admins may delete it.
+func (um *UserManager) DeleteTeam(ctx context.Context, actor *Identity, name string) error {
+	if _, err := um.AuthorizeTeam(ctx, actor, name); err != nil {
+		return err
+	}
+
+	result, err := um.teams.DeleteOne(ctx, bson.M{"name": name})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return ErrTeamNotFound
+	}
+	return nil
+}
+
+// AddTeamMember adds an existing user to a team. Only members and admins
+// may add members.
+func (um *UserManager) AddTeamMember(ctx context.Context, actor *Identity, name, userID string) error {
+	if _, err := um.AuthorizeTeam(ctx, actor, name); err != nil {
+		return err
+	}
+
+	count, err := um.collection.CountDocuments(ctx, bson.M{"user_id": userID})
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return ErrUserNotFound
+	}
+
+	_, err = um.teams.UpdateOne(ctx, bson.M{"name": name}, bson.M{"$addToSet": bson.M{"members": userID}})
+	return err
+}
+
+// RemoveTeamMember removes a user from a team. Only members and admins may
+// remove members; a team without members can only be managed by admins.
+func (um *UserManager) RemoveTeamMember(ctx context.Context, actor *Identity, name, userID string) error {
+	team, err := um.AuthorizeTeam(ctx, actor, name)
+	if err != nil {
+		return err
+	}
+	if !team.HasMember(userID) {
+		return fmt.Errorf("%w: %q is not a member of %q", ErrUserNotFound, userID, name)
+	}
+
+	_, err = um.teams.UpdateOne(ctx, bson.M{"name": name}, bson.M{"$pull": bson.M{"members": userID}})
+	return err
+}
+
+// AuthorizeTeam returns a team if actor may manage it, which members and
+// admins may
+func (um *UserManager) AuthorizeTeam(ctx context.Context, actor *Identity, name string) (*Team, error) {
+	var team Team
+	err := um.teams.FindOne(ctx, bson.M{"name": name}).Decode(&team)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, ErrTeamNotFound
+		}
+		return nil, err
+	}
+
+	if actor == nil || actor.ServiceAccount != "" {
+		return nil, ErrPermissionDenied
+	}
+	if !team.HasMember(actor.UserID) && !actor.Role.Can(PermissionManageUsers) {
+		return nil, fmt.Errorf("%w: not a member of team %q", ErrPermissionDenied, name)
+	}
+	return &team, nil
+}
+
+// teamNames returns the names of the teams userID belongs to
+func (um *UserManager) teamNames(ctx context.Context, userID string) ([]string, error) {
+	teams, err := um.ListTeams(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(teams))
+	for _, team := range teams {
+		names = append(names, team.Name)
+	}
+	return names, nil
+}
+
+// leaveTeams removes a user from every team
+func (um *UserManager) leaveTeams(ctx context.Context, userID string) error {
+	_, err := um.teams.UpdateMany(ctx, bson.M{"members": userID}, bson.M{"$pull": bson.M{"members": userID}})
+	return err
+}
+
+// validateUserID rejects user IDs that cannot name a namespace of
+// configuration files or could be mistaken for a team namespace
+func validateUserID(userID string) error {
+	if strings.HasPrefix(userID, teamPrefix) {
+		return fmt.Errorf("%w: user IDs may not start with %q", ErrInvalidName, teamPrefix)
+	}
+	if err := configurations.ValidateUserID(userID); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidName, err)
+	}
+	return nil
+}
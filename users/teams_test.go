@@ -0,0 +1,30 @@
+package users
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateUserID(t *testing.T) {
+	tests := []struct {
+		userID string
+		valid  bool
+	}{
+		{"alice", true},
+		{"alice@example.com", true},
+		// Team namespaces start with "@"
+		{"@platform", false},
+		{".hidden", false},
+		{"", false},
+		{"alice/bob", false},
+	}
+	for _, test := range tests {
+		err := validateUserID(test.userID)
+		if test.valid != (err == nil) {
+			t.Errorf("validateUserID(%q) = %v, want valid %v", test.userID, err, test.valid)
+		}
+		if err != nil && !errors.Is(err, ErrInvalidName) {
+			t.Errorf("validateUserID(%q) error %v does not wrap ErrInvalidName", test.userID, err)
+		}
+	}
+}
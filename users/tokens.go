@@ -0,0 +1,111 @@
+package users
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// DefaultTokenTTL is how long issued bearer tokens stay valid unless the
+// user manager is configured otherwise
+const DefaultTokenTTL = 24 * time.Hour
+
+// tokenBytes is the amount of randomness in a bearer token
+const tokenBytes = 32
+
+// ErrInvalidToken is returned when a bearer token is unknown, expired or
+// revoked
+var ErrInvalidToken = errors.New("invalid token")
+
+// Token is an issued bearer token. Only the SHA-256 hash of the token is
+// stored so a leaked database does not leak usable credentials.
+type Token struct {
+	Hash      string    `bson:"hash"`
+	UserID    string    `bson:"user_id"`
+	CreatedAt time.Time `bson:"created_at"`
+	ExpiresAt time.Time `bson:"expires_at"`
+}
+
+// IssueToken authenticates a user by password and returns a new opaque
+// bearer token together with its expiry time
+func (um *UserManager) IssueToken(ctx context.Context, userID, password string) (string, time.Time, error) {
+	authenticated, err := um.AuthenticateUser(ctx, userID, password)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	if !authenticated {
+		return "", time.Time{}, errors.New("invalid password")
+	}
+
+	if err := um.ensureIndexes(ctx); err != nil {
+		return "", time.Time{}, err
+	}
+
+	raw := make([]byte, tokenBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return "", time.Time{}, err
+	}
+	token := base64.RawURLEncoding.EncodeToString(raw)
+
+	now := time.Now()
+	record := Token{
+		Hash:      hashToken(token),
+		UserID:    userID,
+		CreatedAt: now,
+		ExpiresAt: now.Add(um.tokenTTL),
+	}
+	if _, err := um.tokens.InsertOne(ctx, record); err != nil {
+		return "", time.Time{}, err
+	}
+
+	return token, record.ExpiresAt, nil
+}
+
+// VerifyToken returns the ID of the user a bearer token was issued to
+func (um *UserManager) VerifyToken(ctx context.Context, token string) (string, error) {
+	var record Token
+	filter := bson.M{
+		"hash":       hashToken(token),
+		"expires_at": bson.M{"$gt": time.Now()},
+	}
+	err := um.tokens.FindOne(ctx, filter).Decode(&record)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return "", ErrInvalidToken
+		}
+		return "", err
+	}
+	return record.UserID, nil
+}
+
+// RevokeToken invalidates a bearer token before it expires
+func (um *UserManager) RevokeToken(ctx context.Context, token string) error {
+	result, err := um.tokens.DeleteOne(ctx, bson.M{"hash": hashToken(token)})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return ErrInvalidToken
+	}
+	return nil
+}
+
+// revokeUserTokens invalidates every bearer token issued to a user
+func (um *UserManager) revokeUserTokens(ctx context.Context, userID string) error {
+	_, err := um.tokens.DeleteMany(ctx, bson.M{"user_id": userID})
+	return err
+}
+
+// hashToken returns the hex encoded SHA-256 digest of a token. Tokens carry
+// enough randomness that a fast unsalted hash is sufficient.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}